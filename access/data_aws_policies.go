@@ -28,6 +28,39 @@ type awsIamPolicyStatement struct {
 	Condition    map[string]map[string]string `json:"Condition,omitempty"`
 }
 
+// vpcManagementActions are only needed, when Databricks creates VPC for workspace. Workspaces in
+// customer-managed VPC, including ones with PrivateLink, should not grant them to cross-account role.
+var vpcManagementActions = map[string]bool{
+	"ec2:AllocateAddress":        true,
+	"ec2:AssociateDhcpOptions":   true,
+	"ec2:AssociateRouteTable":    true,
+	"ec2:AttachInternetGateway":  true,
+	"ec2:CreateDhcpOptions":      true,
+	"ec2:CreateInternetGateway":  true,
+	"ec2:CreateKeyPair":          true,
+	"ec2:CreateNatGateway":       true,
+	"ec2:CreateRoute":            true,
+	"ec2:CreateRouteTable":       true,
+	"ec2:CreateSecurityGroup":    true,
+	"ec2:CreateSubnet":           true,
+	"ec2:CreateVpc":              true,
+	"ec2:CreateVpcEndpoint":      true,
+	"ec2:DeleteDhcpOptions":      true,
+	"ec2:DeleteInternetGateway":  true,
+	"ec2:DeleteKeyPair":          true,
+	"ec2:DeleteNatGateway":       true,
+	"ec2:DeleteRoute":            true,
+	"ec2:DeleteRouteTable":       true,
+	"ec2:DeleteSecurityGroup":    true,
+	"ec2:DeleteSubnet":           true,
+	"ec2:DeleteVpc":              true,
+	"ec2:DeleteVpcEndpoints":     true,
+	"ec2:DetachInternetGateway":  true,
+	"ec2:DisassociateRouteTable": true,
+	"ec2:ModifyVpcAttribute":     true,
+	"ec2:ReleaseAddress":         true,
+}
+
 // DataAwsCrossAccountPolicy ...
 func DataAwsCrossAccountPolicy() *schema.Resource {
 	return &schema.Resource{
@@ -121,6 +154,15 @@ func DataAwsCrossAccountPolicy() *schema.Resource {
 					},
 				},
 			}
+			if d.Get("policy_type").(string) == "customer" {
+				var actions []string
+				for _, action := range policy.Statements[0].Actions.([]string) {
+					if !vpcManagementActions[action] {
+						actions = append(actions, action)
+					}
+				}
+				policy.Statements[0].Actions = actions
+			}
 			if passRoleARNs, ok := d.GetOk("pass_roles"); ok {
 				policy.Statements = append(policy.Statements, &awsIamPolicyStatement{
 					Effect:    "Allow",
@@ -145,6 +187,13 @@ func DataAwsCrossAccountPolicy() *schema.Resource {
 				},
 				Optional: true,
 			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Description:  "managed for Databricks-managed VPC, customer for customer-managed VPC or PrivateLink",
+				Optional:     true,
+				Default:      "managed",
+				ValidateFunc: validation.StringInSlice([]string{"managed", "customer"}, false),
+			},
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -32,6 +32,23 @@ func TestDataAwsCrossAccountPolicy_WithPassRoles(t *testing.T) {
 	assert.Lenf(t, j, 2895, "Strange length for policy: %s", j)
 }
 
+func TestDataAwsCrossAccountPolicy_CustomerManagedVPC(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAwsCrossAccountPolicy(),
+		NonWritable: true,
+		HCL:         `policy_type = "customer"`,
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	j := d.Get("json").(string)
+	assert.Contains(t, j, "ec2:RunInstances")
+	assert.Contains(t, j, "ec2:DescribeNatGateways")
+	assert.NotContains(t, j, "ec2:CreateVpc")
+	assert.NotContains(t, j, "ec2:CreateVpcEndpoint")
+	assert.NotContains(t, j, "ec2:CreateSecurityGroup")
+}
+
 func TestDataAwsAssumeRolePolicy(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Read:        true,
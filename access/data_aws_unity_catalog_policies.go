@@ -0,0 +1,171 @@
+package access
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataAwsUnityCatalogPolicy constructs IAM policy for Unity Catalog storage credential role
+func DataAwsUnityCatalogPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			bucket := d.Get("bucket_name").(string)
+			awsAccountID := d.Get("aws_account_id").(string)
+			roleName := d.Get("role_name").(string)
+			policy := awsIamPolicy{
+				Version: "2012-10-17",
+				Statements: []*awsIamPolicyStatement{
+					{
+						Effect: "Allow",
+						Actions: []string{
+							"s3:GetObject",
+							"s3:PutObject",
+							"s3:DeleteObject",
+							"s3:ListBucket",
+							"s3:GetBucketLocation",
+						},
+						Resources: []string{
+							fmt.Sprintf("arn:aws:s3:::%s/*", bucket),
+							fmt.Sprintf("arn:aws:s3:::%s", bucket),
+						},
+					},
+					{
+						// Unity Catalog requires the role to be self-assuming
+						Effect:    "Allow",
+						Actions:   "sts:AssumeRole",
+						Resources: fmt.Sprintf("arn:aws:iam::%s:role/%s", awsAccountID, roleName),
+					},
+				},
+			}
+			if kmsKey, ok := d.GetOk("kms_name"); ok {
+				policy.Statements = append(policy.Statements, &awsIamPolicyStatement{
+					Effect: "Allow",
+					Actions: []string{
+						"kms:Decrypt",
+						"kms:Encrypt",
+						"kms:GenerateDataKey*",
+					},
+					Resources: kmsKey.(string),
+				})
+			}
+			policyJSON, err := json.MarshalIndent(policy, "", "  ")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(fmt.Sprintf("%s-%s", bucket, roleName))
+			// nolint
+			d.Set("json", string(policyJSON))
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9a-zA-Z_.-]+$`),
+					"must contain only alphanumeric, underscore, period, and hyphen characters"),
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"kms_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// DataAwsUnityCatalogAssumeRolePolicy constructs trust relationship for Unity Catalog storage credential role
+func DataAwsUnityCatalogAssumeRolePolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			awsAccountID := d.Get("aws_account_id").(string)
+			roleName := d.Get("role_name").(string)
+			externalID := d.Get("external_id").(string)
+			policy := awsIamPolicy{
+				Version: "2012-10-17",
+				Statements: []*awsIamPolicyStatement{
+					{
+						Effect:  "Allow",
+						Actions: "sts:AssumeRole",
+						Principal: map[string]string{
+							"AWS": d.Get("unity_catalog_iam_arn").(string),
+						},
+						Condition: map[string]map[string]string{
+							"StringEquals": {
+								"sts:ExternalId": externalID,
+							},
+						},
+					},
+					{
+						Sid:     "ExplicitSelfRoleAssumption",
+						Effect:  "Allow",
+						Actions: "sts:AssumeRole",
+						Principal: map[string]string{
+							"AWS": fmt.Sprintf("arn:aws:iam::%s:root", awsAccountID),
+						},
+						Condition: map[string]map[string]string{
+							"ArnLike": {
+								"aws:PrincipalArn": fmt.Sprintf("arn:aws:iam::%s:role/%s",
+									awsAccountID, roleName),
+							},
+							"StringEquals": {
+								"sts:ExternalId": externalID,
+							},
+						},
+					},
+				},
+			}
+			policyJSON, err := json.MarshalIndent(policy, "", "  ")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(fmt.Sprintf("%s-%s", externalID, roleName))
+			// nolint
+			d.Set("json", string(policyJSON))
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "Databricks account ID or external ID of the storage credential",
+				Required:    true,
+			},
+			"unity_catalog_iam_arn": {
+				Type:     schema.TypeString,
+				Default:  "arn:aws:iam::414351767826:role/unity-catalog-prod-UCMasterRole-14S5ZJVKOTYTL",
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package access
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataAwsUnityCatalogPolicy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAwsUnityCatalogPolicy(),
+		NonWritable: true,
+		HCL: `
+		aws_account_id = "123456789098"
+		bucket_name = "uc-root"
+		role_name = "uc-access"
+		`,
+		ID: ".",
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "uc-root-uc-access", d.Id())
+	var policy awsIamPolicy
+	require.NoError(t, json.Unmarshal([]byte(d.Get("json").(string)), &policy))
+	require.Len(t, policy.Statements, 2)
+	assert.Equal(t, []interface{}{
+		"arn:aws:s3:::uc-root/*",
+		"arn:aws:s3:::uc-root",
+	}, policy.Statements[0].Resources)
+	assert.Equal(t, "arn:aws:iam::123456789098:role/uc-access", policy.Statements[1].Resources)
+}
+
+func TestDataAwsUnityCatalogPolicy_WithKMS(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAwsUnityCatalogPolicy(),
+		NonWritable: true,
+		HCL: `
+		aws_account_id = "123456789098"
+		bucket_name = "uc-root"
+		role_name = "uc-access"
+		kms_name = "arn:aws:kms:us-east-1:123456789098:key/abc"
+		`,
+		ID: ".",
+	}.Apply(t)
+	require.NoError(t, err)
+	var policy awsIamPolicy
+	require.NoError(t, json.Unmarshal([]byte(d.Get("json").(string)), &policy))
+	require.Len(t, policy.Statements, 3)
+	assert.Equal(t, "arn:aws:kms:us-east-1:123456789098:key/abc", policy.Statements[2].Resources)
+}
+
+func TestDataAwsUnityCatalogAssumeRolePolicy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAwsUnityCatalogAssumeRolePolicy(),
+		NonWritable: true,
+		HCL: `
+		aws_account_id = "123456789098"
+		role_name = "uc-access"
+		external_id = "abc-def"
+		`,
+		ID: ".",
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "abc-def-uc-access", d.Id())
+	var policy awsIamPolicy
+	require.NoError(t, json.Unmarshal([]byte(d.Get("json").(string)), &policy))
+	require.Len(t, policy.Statements, 2)
+	assert.Equal(t, "arn:aws:iam::414351767826:role/unity-catalog-prod-UCMasterRole-14S5ZJVKOTYTL",
+		policy.Statements[0].Principal["AWS"])
+	assert.Equal(t, "abc-def", policy.Statements[0].Condition["StringEquals"]["sts:ExternalId"])
+	assert.Equal(t, "arn:aws:iam::123456789098:root", policy.Statements[1].Principal["AWS"])
+	assert.Equal(t, "arn:aws:iam::123456789098:role/uc-access",
+		policy.Statements[1].Condition["ArnLike"]["aws:PrincipalArn"])
+}
@@ -0,0 +1,86 @@
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceSecret lists metadata of secrets within a scope. Secret values are never returned.
+func DataSourceSecret() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validScope,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validScope,
+			},
+			"last_updated_timestamp": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"config_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secrets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_timestamp": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			scope := d.Get("scope").(string)
+			key := d.Get("key").(string)
+			secrets, err := NewSecretsAPI(ctx, m).List(scope)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			found := false
+			list := []map[string]interface{}{}
+			for _, secret := range secrets {
+				list = append(list, map[string]interface{}{
+					"key":                    secret.Key,
+					"last_updated_timestamp": secret.LastUpdatedTimestamp,
+				})
+				if key != "" && secret.Key == key {
+					found = true
+					// nolint
+					d.Set("last_updated_timestamp", secret.LastUpdatedTimestamp)
+					// nolint
+					d.Set("config_reference", fmt.Sprintf("{{secrets/%s/%s}}", scope, key))
+				}
+			}
+			if key != "" && !found {
+				return diag.Errorf("secret %s not found in %s scope", key, scope)
+			}
+			// nolint
+			d.Set("secrets", list)
+			if key != "" {
+				d.SetId(fmt.Sprintf("%s|||%s", scope, key))
+			} else {
+				d.SetId(scope)
+			}
+			return nil
+		},
+	}
+}
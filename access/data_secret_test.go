@@ -0,0 +1,101 @@
+package access
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var secretsListFixture = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/secrets/list?scope=foo",
+	Response: SecretsList{
+		Secrets: []SecretMetadata{
+			{
+				Key:                  "bar",
+				LastUpdatedTimestamp: 12345678,
+			},
+			{
+				Key:                  "baz",
+				LastUpdatedTimestamp: 23456789,
+			},
+		},
+	},
+}
+
+func TestDataSourceSecret(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{secretsListFixture},
+		Resource:    DataSourceSecret(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		State: map[string]interface{}{
+			"scope": "foo",
+		},
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "foo", d.Id())
+	assert.Equal(t, 2, d.Get("secrets.#"))
+	assert.Equal(t, "baz", d.Get("secrets.1.key"))
+	assert.Equal(t, 23456789, d.Get("secrets.1.last_updated_timestamp"))
+}
+
+func TestDataSourceSecret_Key(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{secretsListFixture},
+		Resource:    DataSourceSecret(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		State: map[string]interface{}{
+			"scope": "foo",
+			"key":   "bar",
+		},
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "foo|||bar", d.Id())
+	assert.Equal(t, 12345678, d.Get("last_updated_timestamp"))
+	assert.Equal(t, "{{secrets/foo/bar}}", d.Get("config_reference"))
+}
+
+func TestDataSourceSecret_KeyNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{secretsListFixture},
+		Resource:    DataSourceSecret(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		State: map[string]interface{}{
+			"scope": "foo",
+			"key":   "missing",
+		},
+	}.ExpectError(t, "secret missing not found in foo scope")
+}
+
+func TestDataSourceSecret_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Scope foo does not exist!",
+				},
+				Status: 404,
+			},
+		},
+		Resource:    DataSourceSecret(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		State: map[string]interface{}{
+			"scope": "foo",
+		},
+	}.ExpectError(t, "Scope foo does not exist!")
+}
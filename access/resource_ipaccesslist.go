@@ -2,6 +2,10 @@ package access
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
@@ -91,6 +95,55 @@ func (a ipAccessListsAPI) List() (listResponse listIPAccessListsResponse, err er
 	return
 }
 
+// ipAccessListMaxEntries is the maximal number of IP addresses and CIDR ranges,
+// that API accepts within a single IP access list
+const ipAccessListMaxEntries = 1000
+
+// parseIPv4Network parses IPv4 address or CIDR range into the network, where
+// single address is treated as /32 range
+func parseIPv4Network(v string) (*net.IPNet, error) {
+	cidr := v
+	if !strings.Contains(cidr, "/") {
+		cidr += "/32"
+	}
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil || ip.To4() == nil {
+		return nil, fmt.Errorf("%s is neither IPv4 address nor IPv4 CIDR range", v)
+	}
+	return network, nil
+}
+
+func validateIPv4OrCIDR(i interface{}, k string) (_ []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := parseIPv4Network(v); err != nil {
+		es = append(es, fmt.Errorf("%s: %w", k, err))
+	}
+	return
+}
+
+// overlappingIPRanges returns descriptions of entries, where one range includes the other
+func overlappingIPRanges(entries []string) (overlaps []string) {
+	networks := make([]*net.IPNet, len(entries))
+	for i, v := range entries {
+		networks[i], _ = parseIPv4Network(v)
+	}
+	for i := range networks {
+		for j := i + 1; j < len(networks); j++ {
+			a, b := networks[i], networks[j]
+			if a == nil || b == nil {
+				continue
+			}
+			if a.Contains(b.IP) || b.Contains(a.IP) {
+				overlaps = append(overlaps, fmt.Sprintf("%s and %s", entries[i], entries[j]))
+			}
+		}
+	}
+	return
+}
+
 // ResourceIPAccessList manages IP access lists
 func ResourceIPAccessList() *schema.Resource {
 	s := common.StructToSchema(ipAccessListUpdateRequest{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
@@ -98,13 +151,42 @@ func ResourceIPAccessList() *schema.Resource {
 		s["list_type"].ValidateFunc = validation.StringInSlice([]string{"ALLOW", "BLOCK"}, false)
 		s["ip_addresses"].Elem = &schema.Schema{
 			Type:         schema.TypeString,
-			ValidateFunc: validation.Any(validation.IsIPv4Address, validation.IsCIDR),
+			ValidateFunc: validateIPv4OrCIDR,
 		}
+		s["ip_addresses"].MaxItems = ipAccessListMaxEntries
 		s["enabled"].Default = true
+		s["allow_overlapping_ranges"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
 		return s
 	})
 	return common.Resource{
 		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			if !d.NewValueKnown("ip_addresses") {
+				return nil
+			}
+			var entries []string
+			for _, v := range d.Get("ip_addresses").([]interface{}) {
+				entry, _ := v.(string)
+				entries = append(entries, entry)
+			}
+			// overlapping ranges are accepted by API, though they usually indicate a mistake
+			overlaps := overlappingIPRanges(entries)
+			if len(overlaps) == 0 {
+				return nil
+			}
+			if d.Get("allow_overlapping_ranges").(bool) {
+				log.Printf("[WARN] IP access list %s has overlapping entries: %s",
+					d.Get("label"), strings.Join(overlaps, ", "))
+				return nil
+			}
+			return fmt.Errorf("ip_addresses have overlapping entries: %s. "+
+				"Set allow_overlapping_ranges = true, if it's intended",
+				strings.Join(overlaps, ", "))
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var iacl createIPAccessListRequest
 			if err := common.DataToStructPointer(d, s, &iacl); err != nil {
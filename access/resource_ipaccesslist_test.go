@@ -4,6 +4,7 @@ package access
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
 	"testing"
@@ -333,3 +334,107 @@ func TestIPACLDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "IP access list is not available in ")
 	assert.Equal(t, TestingID, d.Id())
 }
+
+func TestIPACLCreate_InvalidAddress(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceIPAccessList(),
+		State: map[string]interface{}{
+			"label":        TestingLabel,
+			"list_type":    TestingListTypeString,
+			"ip_addresses": []interface{}{"1.2.3.4", "2001:db8::/32"},
+		},
+		Create: true,
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "invalid config supplied.")
+	assert.Contains(t, err.Error(), "2001:db8::/32 is neither IPv4 address nor IPv4 CIDR range")
+}
+
+func TestIPACLCreate_TooManyAddresses(t *testing.T) {
+	addresses := []interface{}{}
+	for i := 0; i <= ipAccessListMaxEntries; i++ {
+		addresses = append(addresses, fmt.Sprintf("10.%d.%d.1", i/256, i%256))
+	}
+	_, err := qa.ResourceFixture{
+		Resource: ResourceIPAccessList(),
+		State: map[string]interface{}{
+			"label":        TestingLabel,
+			"list_type":    TestingListTypeString,
+			"ip_addresses": addresses,
+		},
+		Create: true,
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "invalid config supplied. [ip_addresses] Too many list items")
+}
+
+func TestIPACLCreate_OverlappingAddresses(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceIPAccessList(),
+		State: map[string]interface{}{
+			"label":        TestingLabel,
+			"list_type":    TestingListTypeString,
+			"ip_addresses": []interface{}{"10.0.0.0/8", "10.1.2.3"},
+		},
+		Create: true,
+	}.Apply(t)
+	assert.EqualError(t, err, "ip_addresses have overlapping entries: 10.0.0.0/8 and 10.1.2.3. "+
+		"Set allow_overlapping_ranges = true, if it's intended")
+}
+
+func TestIPACLCreate_AllowOverlappingAddresses(t *testing.T) {
+	addresses := []string{"10.0.0.0/8", "10.1.2.3"}
+	status := ipAccessListStatusWrapper{
+		IPAccessList: ipAccessListStatus{
+			ListID:      TestingID,
+			Label:       TestingLabel,
+			ListType:    TestingListType,
+			IPAddresses: addresses,
+			Enabled:     true,
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/ip-access-lists",
+				ExpectedRequest: createIPAccessListRequest{
+					Label:       TestingLabel,
+					ListType:    TestingListType,
+					IPAddresses: addresses,
+				},
+				Response: status,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists/" + TestingID,
+				Response: status,
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		State: map[string]interface{}{
+			"label":                    TestingLabel,
+			"list_type":                TestingListTypeString,
+			"ip_addresses":             []interface{}{"10.0.0.0/8", "10.1.2.3"},
+			"allow_overlapping_ranges": true,
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, TestingID, d.Id())
+}
+
+func TestValidateIPv4OrCIDR(t *testing.T) {
+	for _, v := range []string{"1.2.3.4", "10.0.0.0/8", "192.168.1.0/24"} {
+		_, es := validateIPv4OrCIDR(v, "ip")
+		assert.Len(t, es, 0, v)
+	}
+	for _, v := range []string{"", "1.2.3", "1.2.3.4/33", "::1", "abc"} {
+		_, es := validateIPv4OrCIDR(v, "ip")
+		assert.Len(t, es, 1, v)
+	}
+}
+
+func TestOverlappingIPRanges(t *testing.T) {
+	assert.Equal(t, []string{"10.0.0.0/8 and 10.1.2.3", "1.2.4.0/24 and 1.2.4.0/25"},
+		overlappingIPRanges([]string{"10.0.0.0/8", "1.2.4.0/24", "10.1.2.3", "1.2.4.0/25", "1.2.5.1"}))
+	assert.Len(t, overlappingIPRanges(TestingIPAddresses), 0)
+}
@@ -228,6 +228,17 @@ func permissionsResourceIDFields(ctx context.Context) []permissionsIDFieldMappin
 		}
 		return strconv.FormatInt(info.ObjectID, 10), nil
 	}
+	DIRECTORY_PATH := func(client *common.DatabricksClient, path string) (string, error) {
+		info, err := workspace.NewNotebooksAPI(ctx, client).Read(path)
+		if err != nil {
+			return "", errors.Wrapf(err, "Cannot load path %s", path)
+		}
+		if info.ObjectType != "" && info.ObjectType != workspace.Directory {
+			return "", fmt.Errorf("%s is %s, not a directory", path, info.ObjectType)
+		}
+		// workspace root directory has object ID 0
+		return strconv.FormatInt(info.ObjectID, 10), nil
+	}
 	return []permissionsIDFieldMapping{
 		{"cluster_policy_id", "cluster-policy", "cluster-policies", []string{"CAN_USE"}, SIMPLE},
 		{"instance_pool_id", "instance-pool", "instance-pools", []string{"CAN_ATTACH_TO", "CAN_MANAGE"}, SIMPLE},
@@ -236,7 +247,10 @@ func permissionsResourceIDFields(ctx context.Context) []permissionsIDFieldMappin
 		{"notebook_id", "notebook", "notebooks", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
 		{"notebook_path", "notebook", "notebooks", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, PATH},
 		{"directory_id", "directory", "directories", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
-		{"directory_path", "directory", "directories", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, PATH},
+		{"directory_path", "directory", "directories", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, DIRECTORY_PATH},
+		{"experiment_id", "mlflowExperiment", "experiments", []string{"CAN_READ", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
+		{"registered_model_id", "registered-model", "registered-models", []string{
+			"CAN_READ", "CAN_EDIT", "CAN_MANAGE_STAGING_VERSIONS", "CAN_MANAGE_PRODUCTION_VERSIONS", "CAN_MANAGE"}, SIMPLE},
 		{"authorization", "tokens", "authorization", []string{"CAN_USE"}, SIMPLE},
 		{"authorization", "passwords", "authorization", []string{"CAN_USE"}, SIMPLE},
 		{"sql_endpoint_id", "endpoints", "sql/endpoints", []string{"CAN_USE", "CAN_MANAGE"}, SIMPLE},
@@ -288,6 +302,34 @@ func (oa *ObjectACL) ToPermissionsEntity(ctx context.Context, d *schema.Resource
 	return entity, fmt.Errorf("unknown object type %s", oa.ObjectType)
 }
 
+// validateDirectoryPath allows permissions on any directory, including workspace root,
+// `/Shared` and `/Repos`, except `/Users`, which permissions are managed by Databricks
+func validateDirectoryPath(i interface{}, p cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return nil
+	}
+	var summary string
+	switch {
+	case !strings.HasPrefix(v, "/"):
+		summary = fmt.Sprintf("directory_path must be absolute: %s", v)
+	case v != "/" && strings.HasSuffix(v, "/"):
+		summary = fmt.Sprintf("directory_path must not end with /: %s", v)
+	case strings.EqualFold(v, "/Users"):
+		summary = "It is not possible to change permissions of /Users directory, " +
+			"use home directories of users instead."
+	default:
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Summary:       summary,
+			Severity:      diag.Error,
+			AttributePath: p,
+		},
+	}
+}
+
 func stringInSlice(a string, list []string) bool {
 	for _, b := range list {
 		if b == a {
@@ -314,6 +356,7 @@ func ResourcePermissions() *schema.Resource {
 				s[mapping.field].ConflictsWith = append(s[mapping.field].ConflictsWith, m.field)
 			}
 		}
+		s["directory_path"].ValidateDiagFunc = validateDirectoryPath
 		s["access_control"].MinItems = 1
 		if groupNameSchema, err := common.SchemaPath(s,
 			"access_control", "group_name"); err == nil {
@@ -342,15 +385,15 @@ func ResourcePermissions() *schema.Resource {
 			return nil
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		me, err := identity.NewUsersAPI(ctx, m).Me()
 		if err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		entity, err := objectACL.ToPermissionsEntity(ctx, d, me.UserName)
 		if err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		if len(entity.AccessControlList) == 0 {
 			// empty "modifiable" access control list is the same as resource absence
@@ -359,7 +402,7 @@ func ResourcePermissions() *schema.Resource {
 		}
 		err = common.StructToData(entity, s, d)
 		if err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		return nil
 	}
@@ -394,20 +437,20 @@ func ResourcePermissions() *schema.Resource {
 			var entity PermissionsEntity
 			err := common.DataToStructPointer(d, s, &entity)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			for _, mapping := range permissionsResourceIDFields(ctx) {
 				if v, ok := d.GetOk(mapping.field); ok {
 					id, err := mapping.idRetriever(m.(*common.DatabricksClient), v.(string))
 					if err != nil {
-						return diag.FromErr(err)
+						return common.DiagnosticsFromErr(err)
 					}
 					objectID := fmt.Sprintf("/%s/%s", mapping.resourceType, id)
 					err = NewPermissionsAPI(ctx, m).Update(objectID, AccessControlChangeList{
 						AccessControlList: entity.AccessControlList,
 					})
 					if err != nil {
-						return diag.FromErr(err)
+						return common.DiagnosticsFromErr(err)
 					}
 					d.SetId(objectID)
 					return readContext(ctx, d, m)
@@ -419,20 +462,20 @@ func ResourcePermissions() *schema.Resource {
 			var entity PermissionsEntity
 			err := common.DataToStructPointer(d, s, &entity)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			err = NewPermissionsAPI(ctx, m).Update(d.Id(), AccessControlChangeList{
 				AccessControlList: entity.AccessControlList,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			return readContext(ctx, d, m)
 		},
 		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			err := NewPermissionsAPI(ctx, m).Delete(d.Id())
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			return nil
 		},
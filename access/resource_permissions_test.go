@@ -422,6 +422,61 @@ func TestResourcePermissionsCreate(t *testing.T) {
 	assert.Equal(t, "CAN_ATTACH_TO", firstElem["permission_level"])
 }
 
+func TestResourcePermissionsCreate_Experiment(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/experiments/123",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_EDIT",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/experiments/123",
+				Response: ObjectACL{
+					ObjectID:   "/experiments/123",
+					ObjectType: "mlflowExperiment",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_EDIT",
+									Inherited:       false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		State: map[string]interface{}{
+			"experiment_id": "123",
+			"access_control": []interface{}{
+				map[string]interface{}{
+					"user_name":        TestingUser,
+					"permission_level": "CAN_EDIT",
+				},
+			},
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/experiments/123", d.Id())
+	assert.Equal(t, "mlflowExperiment", d.Get("object_type"))
+	ac := d.Get("access_control").(*schema.Set)
+	require.Equal(t, 1, len(ac.List()))
+}
+
 func TestResourcePermissionsCreate_SQLA_Asset(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -644,6 +699,117 @@ func TestResourcePermissionsCreate_NotebookPath(t *testing.T) {
 	assert.Equal(t, "CAN_READ", firstElem["permission_level"])
 }
 
+func TestResourcePermissionsCreate_WorkspaceRoot(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2F",
+				Response: workspace.ObjectStatus{
+					ObjectID:   0,
+					ObjectType: "DIRECTORY",
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/directories/0",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							GroupName:       "users",
+							PermissionLevel: "CAN_READ",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/directories/0",
+				Response: ObjectACL{
+					ObjectID:   "/directories/0",
+					ObjectType: "directory",
+					AccessControlList: []AccessControl{
+						{
+							GroupName: "users",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_READ",
+									Inherited:       false,
+								},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_MANAGE",
+									Inherited:       false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		directory_path = "/"
+		access_control {
+			group_name = "users"
+			permission_level = "CAN_READ"
+		}`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/directories/0", d.Id())
+	assert.Equal(t, "/", d.Get("directory_path"))
+	assert.Equal(t, 1, d.Get("access_control.#"))
+}
+
+func TestResourcePermissionsCreate_DirectoryPathIsNotDirectory(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2FInit",
+				Response: workspace.ObjectStatus{
+					ObjectID:   988765,
+					ObjectType: "NOTEBOOK",
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		HCL: `
+		directory_path = "/Shared/Init"
+		access_control {
+			group_name = "users"
+			permission_level = "CAN_READ"
+		}`,
+		Create: true,
+	}.ExpectError(t, "/Shared/Init is NOTEBOOK, not a directory")
+}
+
+func TestResourcePermissionsCreate_DirectoryPathValidation(t *testing.T) {
+	for path, message := range map[string]string{
+		"/Users":  "It is not possible to change permissions of /Users directory, use home directories of users instead.",
+		"/Repos/": "directory_path must not end with /: /Repos/",
+		"Shared":  "directory_path must be absolute: Shared",
+	} {
+		qa.ResourceFixture{
+			Resource: ResourcePermissions(),
+			HCL: fmt.Sprintf(`
+			directory_path = "%s"
+			access_control {
+				group_name = "users"
+				permission_level = "CAN_MANAGE"
+			}`, path),
+			Create: true,
+		}.ExpectError(t, "invalid config supplied. [directory_path] "+message)
+	}
+}
+
 func TestResourcePermissionsCreate_error(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -3,6 +3,7 @@ package access
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
@@ -126,7 +127,6 @@ func ResourceSecret() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.StringIsNotEmpty,
 				Required:     true,
-				ForceNew:     true,
 				Sensitive:    true,
 			},
 			"scope": {
@@ -163,8 +163,26 @@ func ResourceSecret() *schema.Resource {
 			if err != nil {
 				return err
 			}
+			known := int64(d.Get("last_updated_timestamp").(int))
+			if known != 0 && known != m.LastUpdatedTimestamp {
+				// secret values are write-only, so the value is marked as changed
+				// for it to be written again on the next apply
+				log.Printf("[WARN] Secret %s was overwritten outside of Terraform", d.Id())
+				d.Set("string_value", "") // nolint
+			}
 			return d.Set("last_updated_timestamp", m.LastUpdatedTimestamp)
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			scope, key, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			if err = NewSecretsAPI(ctx, c).Create(d.Get("string_value").(string), scope, key); err != nil {
+				return err
+			}
+			// secret gets new timestamp, that must not be treated as a change outside of Terraform
+			return d.Set("last_updated_timestamp", 0)
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			scope, key, err := p.Unpack(d)
 			if err != nil {
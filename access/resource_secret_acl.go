@@ -6,6 +6,7 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // NewSecretAclsAPI creates SecretAclsAPI instance from provider meta
@@ -74,7 +75,11 @@ func ResourceSecretACL() *schema.Resource {
 			"permission": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(ACLPermissionRead),
+					string(ACLPermissionWrite),
+					string(ACLPermissionManage),
+				}, false),
 			},
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -98,6 +103,15 @@ func ResourceSecretACL() *schema.Resource {
 			}
 			return d.Set("permission", secretACL.Permission)
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			scope, principal, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			// put overwrites existing ACL for the principal
+			return NewSecretAclsAPI(ctx, c).Create(scope, principal,
+				ACLPermission(d.Get("permission").(string)))
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			scope, principal, err := p.Unpack(d)
 			if err != nil {
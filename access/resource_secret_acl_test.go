@@ -86,7 +86,7 @@ func TestResourceSecretACLRead(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.0/secrets/acls/get?principal=something&scope=global",
 				Response: ACLItem{
-					Permission: "CAN_MANAGE",
+					Permission: "MANAGE",
 				},
 			},
 		},
@@ -96,7 +96,7 @@ func TestResourceSecretACLRead(t *testing.T) {
 	}.Apply(t)
 	assert.NoError(t, err, err)
 	assert.Equal(t, "global|||something", d.Id(), "Id should not be empty")
-	assert.Equal(t, "CAN_MANAGE", d.Get("permission"))
+	assert.Equal(t, "MANAGE", d.Get("permission"))
 	assert.Equal(t, "something", d.Get("principal"))
 	assert.Equal(t, "global", d.Get("scope"))
 }
@@ -150,7 +150,7 @@ func TestResourceSecretACLCreate(t *testing.T) {
 				Resource: "/api/2.0/secrets/acls/put",
 				ExpectedRequest: SecretACLRequest{
 					Principal:  "something",
-					Permission: "CAN_MANAGE",
+					Permission: "MANAGE",
 					Scope:      "global",
 				},
 			},
@@ -158,13 +158,13 @@ func TestResourceSecretACLCreate(t *testing.T) {
 				Method:   "GET",
 				Resource: "/api/2.0/secrets/acls/get?principal=something&scope=global",
 				Response: ACLItem{
-					Permission: "CAN_MANAGE",
+					Permission: "MANAGE",
 				},
 			},
 		},
 		Resource: ResourceSecretACL(),
 		State: map[string]interface{}{
-			"permission": "CAN_MANAGE",
+			"permission": "MANAGE",
 			"principal":  "something",
 			"scope":      "global",
 		},
@@ -189,7 +189,7 @@ func TestResourceSecretACLCreate_Error(t *testing.T) {
 		},
 		Resource: ResourceSecretACL(),
 		State: map[string]interface{}{
-			"permission": "CAN_MANAGE",
+			"permission": "MANAGE",
 			"principal":  "something",
 			"scope":      "global",
 		},
@@ -239,3 +239,53 @@ func TestResourceSecretACLDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "global|||something", d.Id())
 }
+
+func TestResourceSecretACLUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/acls/put",
+				ExpectedRequest: SecretACLRequest{
+					Principal:  "something",
+					Permission: "WRITE",
+					Scope:      "global",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/acls/get?principal=something&scope=global",
+				Response: ACLItem{
+					Permission: "WRITE",
+				},
+			},
+		},
+		Resource: ResourceSecretACL(),
+		InstanceState: map[string]string{
+			"permission": "READ",
+			"principal":  "something",
+			"scope":      "global",
+		},
+		State: map[string]interface{}{
+			"permission": "WRITE",
+			"principal":  "something",
+			"scope":      "global",
+		},
+		Update: true,
+		ID:     "global|||something",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "WRITE", d.Get("permission"))
+}
+
+func TestResourceSecretACLCreate_InvalidPermission(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSecretACL(),
+		State: map[string]interface{}{
+			"permission": "CAN_MANAGE",
+			"principal":  "something",
+			"scope":      "global",
+		},
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [permission] expected permission to be one of [READ WRITE MANAGE], got CAN_MANAGE")
+}
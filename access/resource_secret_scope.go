@@ -3,10 +3,12 @@ package access
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"regexp"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/identity"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -114,7 +116,7 @@ var validScope = validation.StringMatch(regexp.MustCompile(`^[\w\.@_-]{1,128}$`)
 	"Must consist of alphanumeric characters, dashes, underscores, and periods, "+
 		"and may not exceed 128 characters.")
 
-func kvDiffFunc(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+func kvDiffFunc(ctx context.Context, diff *schema.ResourceDiff, c *common.DatabricksClient) error {
 	if diff == nil {
 		return nil
 	}
@@ -122,21 +124,54 @@ func kvDiffFunc(ctx context.Context, diff *schema.ResourceDiff, v interface{}) e
 	if len(kvLst) == 0 {
 		return nil
 	}
-	client := v.(*common.DatabricksClient)
-	if client.IsAzure() && client.AzureAuth.IsClientSecretSet() {
+	if c.IsAzure() && c.AzureAuth.IsClientSecretSet() {
 		return fmt.Errorf("you can't set up Azure KeyVault-based secret scope via Service Principal")
 	}
 	return nil
 }
 
+// creationOnlySuppressFunc suppresses diff for attributes, that are used only during
+// the scope creation and cannot be read back, so that imported scopes are not re-created
+func creationOnlySuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" && d.Id() != "" {
+		log.Printf("[DEBUG] Suppressing diff for k=%#v old=%#v new=%#v", k, old, new)
+		return true
+	}
+	return false
+}
+
+// revokeCreatorManage removes MANAGE permission, that is granted to the caller on scope creation
+func revokeCreatorManage(ctx context.Context, c *common.DatabricksClient, scope SecretScope) error {
+	me, err := identity.NewUsersAPI(ctx, c).Me()
+	if err != nil {
+		return err
+	}
+	if me.UserName == scope.InitialManagePrincipal {
+		return nil
+	}
+	err = NewSecretAclsAPI(ctx, c).Delete(scope.Name, me.UserName)
+	if err != nil {
+		return fmt.Errorf("cannot revoke MANAGE of %s on %s: %w", me.UserName, scope.Name, err)
+	}
+	return nil
+}
+
 // ResourceSecretScope manages secret scopes
 func ResourceSecretScope() *schema.Resource {
 	s := common.StructToSchema(SecretScope{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
-		// TODO: DiffSuppressFunc for initial_manage_principal & importing
 		s["name"].ForceNew = true
 		// nolint
 		s["name"].ValidateFunc = validScope
 		s["initial_manage_principal"].ForceNew = true
+		// nolint
+		s["initial_manage_principal"].ValidateFunc = validation.StringInSlice([]string{"users"}, false)
+		s["initial_manage_principal"].DiffSuppressFunc = creationOnlySuppressFunc
+		s["revoke_creator_manage"] = &schema.Schema{
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Default:          false,
+			DiffSuppressFunc: creationOnlySuppressFunc,
+		}
 		s["keyvault_metadata"].ForceNew = true
 
 		return s
@@ -154,6 +189,9 @@ func ResourceSecretScope() *schema.Resource {
 				return err
 			}
 			d.SetId(scope.Name)
+			if d.Get("revoke_creator_manage").(bool) {
+				return revokeCreatorManage(ctx, c, scope)
+			}
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -163,6 +201,18 @@ func ResourceSecretScope() *schema.Resource {
 			}
 			return common.StructToData(scope, s, d)
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// MANAGE of the creator can't be granted back, so only enabling
+			// revoke_creator_manage has an effect on existing scope
+			if !d.HasChange("revoke_creator_manage") || !d.Get("revoke_creator_manage").(bool) {
+				return nil
+			}
+			var scope SecretScope
+			if err := common.DataToStructPointer(d, s, &scope); err != nil {
+				return err
+			}
+			return revokeCreatorManage(ctx, c, scope)
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewSecretScopesAPI(ctx, c).Delete(d.Id())
 		},
@@ -5,7 +5,10 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
 	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/identity"
 
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
@@ -254,7 +257,7 @@ func TestResourceSecretScopeCreate_Error(t *testing.T) {
 		},
 		Resource: ResourceSecretScope(),
 		State: map[string]interface{}{
-			"initial_manage_principal": "groups",
+			"initial_manage_principal": "users",
 			"name":                     "Boom",
 		},
 		Create: true,
@@ -304,7 +307,7 @@ func TestResourceSecretScopeDelete_Error(t *testing.T) {
 }
 
 func TestKVDiffFuncNil(t *testing.T) {
-	err := kvDiffFunc(context.TODO(), nil, common.DatabricksClient{Host: ""})
+	err := kvDiffFunc(context.TODO(), nil, &common.DatabricksClient{Host: ""})
 	assert.Nil(t, err)
 }
 
@@ -336,3 +339,192 @@ func TestKVDiffFuncSPN(t *testing.T) {
 		Create: true,
 	}.ExpectError(t, "you can't set up Azure KeyVault-based secret scope via Service Principal")
 }
+
+func TestResourceSecretScopeCreate_RevokeCreatorManage(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/scopes/create",
+				ExpectedRequest: map[string]string{
+					"scope":                    "Boom",
+					"initial_manage_principal": "users",
+					"scope_backend_type":       "DATABRICKS",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Me",
+				Response: identity.ScimUser{
+					ID:       "123",
+					UserName: "creator@example.com",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/acls/delete",
+				ExpectedRequest: SecretACLRequest{
+					Scope:     "Boom",
+					Principal: "creator@example.com",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/secrets/scopes/list",
+				Response: SecretScopeList{
+					Scopes: []SecretScope{
+						{
+							Name:        "Boom",
+							BackendType: "DATABRICKS",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecretScope(),
+		HCL: `name = "Boom"
+		initial_manage_principal = "users"
+		revoke_creator_manage = true`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "Boom", d.Id())
+}
+
+func TestResourceSecretScopeCreate_RevokeCreatorManageError(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/scopes/create",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Me",
+				Response: identity.ScimUser{
+					UserName: "creator@example.com",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/acls/delete",
+				Status:   400,
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_STATE",
+					Message:   "Cannot remove last MANAGE",
+				},
+			},
+		},
+		Resource: ResourceSecretScope(),
+		HCL: `name = "Boom"
+		revoke_creator_manage = true`,
+		Create: true,
+	}.Apply(t)
+	assert.EqualError(t, err, "cannot revoke MANAGE of creator@example.com on Boom: Cannot remove last MANAGE")
+}
+
+func TestResourceSecretScopeUpdate_RevokeCreatorManage(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Me",
+				Response: identity.ScimUser{
+					UserName: "creator@example.com",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/acls/delete",
+				ExpectedRequest: SecretACLRequest{
+					Scope:     "Boom",
+					Principal: "creator@example.com",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/secrets/scopes/list",
+				Response: SecretScopeList{
+					Scopes: []SecretScope{
+						{
+							Name:        "Boom",
+							BackendType: "DATABRICKS",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecretScope(),
+		InstanceState: map[string]string{
+			"name":                     "Boom",
+			"initial_manage_principal": "users",
+			"revoke_creator_manage":    "false",
+			"backend_type":             "DATABRICKS",
+		},
+		HCL: `name = "Boom"
+		initial_manage_principal = "users"
+		revoke_creator_manage = true`,
+		Update: true,
+		ID:     "Boom",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, true, d.Get("revoke_creator_manage"))
+}
+
+func TestResourceSecretScopeUpdate_KeepCreatorManage(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/secrets/scopes/list",
+				Response: SecretScopeList{
+					Scopes: []SecretScope{
+						{
+							Name:        "Boom",
+							BackendType: "DATABRICKS",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecretScope(),
+		InstanceState: map[string]string{
+			"name":                  "Boom",
+			"revoke_creator_manage": "true",
+			"backend_type":          "DATABRICKS",
+		},
+		HCL: `name = "Boom"
+		revoke_creator_manage = false`,
+		Update: true,
+		ID:     "Boom",
+	}.ApplyNoError(t)
+}
+
+func TestResourceSecretScopeCreate_InvalidInitialManagePrincipal(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceSecretScope(),
+		HCL: `name = "Boom"
+		initial_manage_principal = "admins"`,
+		Create: true,
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "invalid config supplied. [initial_manage_principal]")
+}
+
+func TestResourceSecretScopeDiff_ImportedScopeIsNotRecreated(t *testing.T) {
+	diff, err := ResourceSecretScope().Diff(context.Background(), &terraform.InstanceState{
+		ID: "Boom",
+		Attributes: map[string]string{
+			"id":           "Boom",
+			"name":         "Boom",
+			"backend_type": "DATABRICKS",
+		},
+	}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                     "Boom",
+		"initial_manage_principal": "users",
+		"revoke_creator_manage":    true,
+	}), nil)
+	require.NoError(t, err)
+	if diff != nil {
+		assert.False(t, diff.RequiresNew(), "%v", diff)
+	}
+}
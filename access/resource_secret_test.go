@@ -183,3 +183,109 @@ func TestResourceSecretDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "foo|||bar", d.Id())
 }
+
+func TestResourceSecretRead_OverwrittenOutside(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{
+							Key:                  "bar",
+							LastUpdatedTimestamp: 22345678,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecret(),
+		Read:     true,
+		ID:       "foo|||bar",
+		State: map[string]interface{}{
+			"scope":                  "foo",
+			"key":                    "bar",
+			"string_value":           "SparkIsTh3Be$t",
+			"last_updated_timestamp": 12345678,
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 22345678, d.Get("last_updated_timestamp"))
+	assert.Equal(t, "", d.Get("string_value"))
+}
+
+func TestResourceSecretRead_NotChanged(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{
+							Key:                  "bar",
+							LastUpdatedTimestamp: 12345678,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecret(),
+		Read:     true,
+		ID:       "foo|||bar",
+		State: map[string]interface{}{
+			"scope":                  "foo",
+			"key":                    "bar",
+			"string_value":           "SparkIsTh3Be$t",
+			"last_updated_timestamp": 12345678,
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "SparkIsTh3Be$t", d.Get("string_value"))
+}
+
+func TestResourceSecretUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/put",
+				ExpectedRequest: SecretsRequest{
+					StringValue: "SparkIsTh3Be$t",
+					Scope:       "foo",
+					Key:         "bar",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{
+							Key:                  "bar",
+							LastUpdatedTimestamp: 32345678,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecret(),
+		Update:   true,
+		ID:       "foo|||bar",
+		InstanceState: map[string]string{
+			"scope":                  "foo",
+			"key":                    "bar",
+			"string_value":           "",
+			"last_updated_timestamp": "22345678",
+		},
+		State: map[string]interface{}{
+			"scope":        "foo",
+			"key":          "bar",
+			"string_value": "SparkIsTh3Be$t",
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 32345678, d.Get("last_updated_timestamp"))
+	assert.Equal(t, "SparkIsTh3Be$t", d.Get("string_value"))
+}
@@ -0,0 +1,263 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DefaultProvisionTimeout is the time, that it usually takes to start app compute and deploy the code
+const DefaultProvisionTimeout = 20 * time.Minute
+
+var appNameRegex = regexp.MustCompile(`^[a-z0-9-]{2,30}$`)
+
+// AppResourceSecret gives app access to a secret
+type AppResourceSecret struct {
+	Scope      string `json:"scope"`
+	Key        string `json:"key"`
+	Permission string `json:"permission"`
+}
+
+// AppResourceSQLWarehouse gives app access to a SQL warehouse
+type AppResourceSQLWarehouse struct {
+	ID         string `json:"id"`
+	Permission string `json:"permission"`
+}
+
+// AppResourceServingEndpoint gives app access to a model serving endpoint
+type AppResourceServingEndpoint struct {
+	Name       string `json:"name"`
+	Permission string `json:"permission"`
+}
+
+// AppResourceJob gives app access to a job
+type AppResourceJob struct {
+	ID         string `json:"id"`
+	Permission string `json:"permission"`
+}
+
+// AppResource is a workspace object, that app's service principal is granted access to
+type AppResource struct {
+	Name            string                      `json:"name"`
+	Description     string                      `json:"description,omitempty"`
+	Secret          *AppResourceSecret          `json:"secret,omitempty"`
+	SQLWarehouse    *AppResourceSQLWarehouse    `json:"sql_warehouse,omitempty"`
+	ServingEndpoint *AppResourceServingEndpoint `json:"serving_endpoint,omitempty"`
+	Job             *AppResourceJob             `json:"job,omitempty"`
+}
+
+// AppStatus describes state of app compute, app itself or its deployment
+type AppStatus struct {
+	State   string `json:"state,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// AppDeployment is a snapshot of source code, that is running within an app
+type AppDeployment struct {
+	DeploymentID   string     `json:"deployment_id,omitempty"`
+	SourceCodePath string     `json:"source_code_path"`
+	Status         *AppStatus `json:"status,omitempty"`
+}
+
+// App is a data application, that runs on serverless compute within a workspace
+type App struct {
+	Name                 string         `json:"name"`
+	Description          string         `json:"description,omitempty"`
+	Resources            []AppResource  `json:"resources,omitempty" tf:"alias:resource"`
+	URL                  string         `json:"url,omitempty" tf:"computed"`
+	ServicePrincipalID   int64          `json:"service_principal_id,omitempty" tf:"computed"`
+	ServicePrincipalName string         `json:"service_principal_name,omitempty" tf:"computed"`
+	ComputeStatus        *AppStatus     `json:"compute_status,omitempty" tf:"computed"`
+	AppStatus            *AppStatus     `json:"app_status,omitempty" tf:"computed"`
+	ActiveDeployment     *AppDeployment `json:"active_deployment,omitempty"`
+}
+
+type appUpdateRequest struct {
+	Description string        `json:"description,omitempty"`
+	Resources   []AppResource `json:"resources"`
+}
+
+// NewAppsAPI creates AppsAPI instance from provider meta
+func NewAppsAPI(ctx context.Context, m interface{}) AppsAPI {
+	return AppsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// AppsAPI exposes the Databricks Apps API
+type AppsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Create creates an app without waiting for its compute
+func (a AppsAPI) Create(app App) error {
+	return a.client.Post(a.context, "/apps", App{
+		Name:        app.Name,
+		Description: app.Description,
+		Resources:   app.Resources,
+	}, nil)
+}
+
+// Get returns app by name
+func (a AppsAPI) Get(name string) (app App, err error) {
+	err = a.client.Get(a.context, "/apps/"+name, nil, &app)
+	return
+}
+
+// Update changes description and resources of an app
+func (a AppsAPI) Update(name string, app App) error {
+	return a.client.Patch(a.context, "/apps/"+name, appUpdateRequest{
+		Description: app.Description,
+		Resources:   app.Resources,
+	})
+}
+
+// Delete removes an app
+func (a AppsAPI) Delete(name string) error {
+	return a.client.Delete(a.context, "/apps/"+name, nil)
+}
+
+// Deploy deploys source code from workspace path to an app and waits until deployment succeeds
+func (a AppsAPI) Deploy(name, sourceCodePath string, timeout time.Duration) error {
+	var deployment AppDeployment
+	err := a.client.Post(a.context, "/apps/"+name+"/deployments", AppDeployment{
+		SourceCodePath: sourceCodePath,
+	}, &deployment)
+	if err != nil {
+		return err
+	}
+	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		err := a.client.Get(a.context, fmt.Sprintf("/apps/%s/deployments/%s",
+			name, deployment.DeploymentID), nil, &deployment)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if deployment.Status == nil {
+			return resource.RetryableError(fmt.Errorf("deployment %s of app %s has no status yet",
+				deployment.DeploymentID, name))
+		}
+		switch deployment.Status.State {
+		case "SUCCEEDED":
+			return nil
+		case "FAILED", "CANCELLED":
+			return resource.NonRetryableError(fmt.Errorf("deployment %s of app %s is %s: %s",
+				deployment.DeploymentID, name, deployment.Status.State, deployment.Status.Message))
+		}
+		message := fmt.Sprintf("deployment %s of app %s is %s: %s", deployment.DeploymentID,
+			name, deployment.Status.State, deployment.Status.Message)
+		log.Printf("[DEBUG] %s", message)
+		return resource.RetryableError(fmt.Errorf(message))
+	})
+}
+
+func (a AppsAPI) waitForCompute(name string, timeout time.Duration) error {
+	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		app, err := a.Get(name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if app.ComputeStatus == nil {
+			return resource.RetryableError(fmt.Errorf("app %s has no compute status yet", name))
+		}
+		switch app.ComputeStatus.State {
+		case "ACTIVE":
+			return nil
+		case "ERROR":
+			return resource.NonRetryableError(fmt.Errorf("compute of app %s failed: %s",
+				name, app.ComputeStatus.Message))
+		}
+		message := fmt.Sprintf("compute of app %s is %s: %s", name,
+			app.ComputeStatus.State, app.ComputeStatus.Message)
+		log.Printf("[DEBUG] %s", message)
+		return resource.RetryableError(fmt.Errorf(message))
+	})
+}
+
+// ResourceApp manages Databricks Apps
+func ResourceApp() *schema.Resource {
+	s := common.StructToSchema(App{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		delete(m, "active_deployment")
+		m["name"].ForceNew = true
+		m["name"].ValidateDiagFunc = validation.ToDiagFunc(validation.StringMatch(
+			appNameRegex, "must contain only lowercase alphanumeric characters and dashes"))
+		m["source_code_path"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+		for field, permissions := range map[string][]string{
+			"secret":           {"READ", "WRITE", "MANAGE"},
+			"sql_warehouse":    {"CAN_USE", "CAN_MANAGE", "IS_OWNER"},
+			"serving_endpoint": {"CAN_QUERY", "CAN_VIEW", "CAN_MANAGE"},
+			"job":              {"CAN_VIEW", "CAN_MANAGE_RUN", "CAN_MANAGE", "IS_OWNER"},
+		} {
+			if v, err := common.SchemaPath(m, "resource", field, "permission"); err == nil {
+				v.ValidateFunc = validation.StringInSlice(permissions, false)
+			}
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var app App
+			if err := common.DataToStructPointer(d, s, &app); err != nil {
+				return err
+			}
+			appsAPI := NewAppsAPI(ctx, c)
+			if err := appsAPI.Create(app); err != nil {
+				return err
+			}
+			// app exists from now on, so failed compute leaves it tainted instead of orphaned
+			d.SetId(app.Name)
+			if err := appsAPI.waitForCompute(app.Name, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+			sourceCodePath := d.Get("source_code_path").(string)
+			if sourceCodePath == "" {
+				return nil
+			}
+			return appsAPI.Deploy(app.Name, sourceCodePath, d.Timeout(schema.TimeoutCreate))
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			app, err := NewAppsAPI(ctx, c).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			if app.ActiveDeployment != nil {
+				d.Set("source_code_path", app.ActiveDeployment.SourceCodePath)
+			}
+			return common.StructToData(app, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var app App
+			if err := common.DataToStructPointer(d, s, &app); err != nil {
+				return err
+			}
+			appsAPI := NewAppsAPI(ctx, c)
+			if d.HasChanges("description", "resource") {
+				if err := appsAPI.Update(d.Id(), app); err != nil {
+					return err
+				}
+			}
+			sourceCodePath := d.Get("source_code_path").(string)
+			if !d.HasChange("source_code_path") || sourceCodePath == "" {
+				return nil
+			}
+			return appsAPI.Deploy(d.Id(), sourceCodePath, d.Timeout(schema.TimeoutUpdate))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewAppsAPI(ctx, c).Delete(d.Id())
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
+			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
+		},
+	}.ToResource()
+}
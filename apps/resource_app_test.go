@@ -0,0 +1,269 @@
+package apps
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceAppCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceApp())
+}
+
+func activeApp() App {
+	return App{
+		Name:                 "dashboard",
+		Description:          "Sales dashboard",
+		URL:                  "https://dashboard-123.aws.databricksapps.com",
+		ServicePrincipalID:   456,
+		ServicePrincipalName: "app-dashboard",
+		Resources: []AppResource{
+			{
+				Name: "warehouse",
+				SQLWarehouse: &AppResourceSQLWarehouse{
+					ID:         "abc",
+					Permission: "CAN_USE",
+				},
+			},
+		},
+		ComputeStatus: &AppStatus{
+			State: "ACTIVE",
+		},
+		AppStatus: &AppStatus{
+			State: "RUNNING",
+		},
+		ActiveDeployment: &AppDeployment{
+			DeploymentID:   "d1",
+			SourceCodePath: "/Workspace/Apps/dashboard",
+		},
+	}
+}
+
+func TestResourceAppCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/apps",
+				ExpectedRequest: App{
+					Name:        "dashboard",
+					Description: "Sales dashboard",
+					Resources: []AppResource{
+						{
+							Name: "warehouse",
+							SQLWarehouse: &AppResourceSQLWarehouse{
+								ID:         "abc",
+								Permission: "CAN_USE",
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/apps/dashboard",
+				Responses: []interface{}{
+					App{
+						Name: "dashboard",
+						ComputeStatus: &AppStatus{
+							State: "STARTING",
+						},
+					},
+					activeApp(),
+				},
+				ReuseRequest: true,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/apps/dashboard/deployments",
+				ExpectedRequest: AppDeployment{
+					SourceCodePath: "/Workspace/Apps/dashboard",
+				},
+				Response: AppDeployment{
+					DeploymentID: "d1",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/apps/dashboard/deployments/d1",
+				Response: AppDeployment{
+					DeploymentID:   "d1",
+					SourceCodePath: "/Workspace/Apps/dashboard",
+					Status: &AppStatus{
+						State: "SUCCEEDED",
+					},
+				},
+			},
+		},
+		Resource: ResourceApp(),
+		Create:   true,
+		HCL: `
+		name = "dashboard"
+		description = "Sales dashboard"
+		source_code_path = "/Workspace/Apps/dashboard"
+		resource {
+			name = "warehouse"
+			sql_warehouse {
+				id = "abc"
+				permission = "CAN_USE"
+			}
+		}`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "dashboard", d.Id())
+	assert.Equal(t, "https://dashboard-123.aws.databricksapps.com", d.Get("url"))
+	assert.Equal(t, "app-dashboard", d.Get("service_principal_name"))
+	assert.Equal(t, "ACTIVE", d.Get("compute_status.0.state"))
+	assert.Equal(t, "/Workspace/Apps/dashboard", d.Get("source_code_path"))
+}
+
+func TestResourceAppCreate_ComputeError(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/apps",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/apps/dashboard",
+				Response: App{
+					Name: "dashboard",
+					ComputeStatus: &AppStatus{
+						State:   "ERROR",
+						Message: "Quota exceeded",
+					},
+				},
+			},
+		},
+		Resource: ResourceApp(),
+		Create:   true,
+		HCL:      `name = "dashboard"`,
+	}.Apply(t)
+	assert.EqualError(t, err, "compute of app dashboard failed: Quota exceeded")
+	assert.Equal(t, "dashboard", d.Id(), "app has to be tainted, not orphaned")
+}
+
+func TestResourceAppCreate_InvalidPermission(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceApp(),
+		Create:   true,
+		HCL: `
+		name = "dashboard"
+		resource {
+			name = "endpoint"
+			serving_endpoint {
+				name = "churn"
+				permission = "CAN_USE"
+			}
+		}`,
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "invalid config supplied.")
+}
+
+func TestResourceAppUpdate_Deploy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/apps/dashboard/deployments",
+				ExpectedRequest: AppDeployment{
+					SourceCodePath: "/Workspace/Apps/dashboard",
+				},
+				Response: AppDeployment{
+					DeploymentID: "d2",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/apps/dashboard/deployments/d2",
+				Response: AppDeployment{
+					DeploymentID: "d2",
+					Status: &AppStatus{
+						State:   "FAILED",
+						Message: "app.yaml not found",
+					},
+				},
+			},
+		},
+		Resource: ResourceApp(),
+		Update:   true,
+		ID:       "dashboard",
+		InstanceState: map[string]string{
+			"name":             "dashboard",
+			"description":      "Sales dashboard",
+			"source_code_path": "/Workspace/Apps/old",
+		},
+		HCL: `
+		name = "dashboard"
+		description = "Sales dashboard"
+		source_code_path = "/Workspace/Apps/dashboard"`,
+	}.Apply(t)
+	assert.EqualError(t, err, "deployment d2 of app dashboard is FAILED: app.yaml not found")
+	assert.Equal(t, "dashboard", d.Id())
+}
+
+func TestResourceAppUpdate_Resources(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/apps/dashboard",
+				ExpectedRequest: appUpdateRequest{
+					Description: "Sales dashboard",
+					Resources: []AppResource{
+						{
+							Name: "warehouse",
+							SQLWarehouse: &AppResourceSQLWarehouse{
+								ID:         "abc",
+								Permission: "CAN_USE",
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/apps/dashboard",
+				Response: activeApp(),
+			},
+		},
+		Resource: ResourceApp(),
+		Update:   true,
+		ID:       "dashboard",
+		InstanceState: map[string]string{
+			"name":             "dashboard",
+			"description":      "Sales dashboard",
+			"source_code_path": "/Workspace/Apps/dashboard",
+		},
+		HCL: `
+		name = "dashboard"
+		description = "Sales dashboard"
+		source_code_path = "/Workspace/Apps/dashboard"
+		resource {
+			name = "warehouse"
+			sql_warehouse {
+				id = "abc"
+				permission = "CAN_USE"
+			}
+		}`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceAppDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/apps/dashboard",
+			},
+		},
+		Resource: ResourceApp(),
+		Delete:   true,
+		ID:       "dashboard",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "dashboard", d.Id())
+}
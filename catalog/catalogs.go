@@ -0,0 +1,58 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+)
+
+// NewCatalogsAPI creates CatalogsAPI instance from provider meta
+func NewCatalogsAPI(ctx context.Context, m interface{}) CatalogsAPI {
+	return CatalogsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// CatalogsAPI exposes catalogs and schemas of Unity Catalog metastore
+type CatalogsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// CatalogInfo is the top-level container of Unity Catalog objects
+type CatalogInfo struct {
+	Name    string `json:"name"`
+	Comment string `json:"comment,omitempty"`
+	Owner   string `json:"owner,omitempty"`
+}
+
+type catalogsList struct {
+	Catalogs []CatalogInfo `json:"catalogs"`
+}
+
+// SchemaInfo is the container of tables and functions within catalog
+type SchemaInfo struct {
+	Name        string `json:"name"`
+	CatalogName string `json:"catalog_name"`
+	FullName    string `json:"full_name,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+}
+
+type schemasList struct {
+	Schemas []SchemaInfo `json:"schemas"`
+}
+
+// List returns catalogs of the current metastore, that are visible to the caller
+func (a CatalogsAPI) List() ([]CatalogInfo, error) {
+	var cl catalogsList
+	err := a.client.Get(a.context, "/unity-catalog/catalogs", nil, &cl)
+	return cl.Catalogs, err
+}
+
+// ListSchemas returns schemas of the catalog, that are visible to the caller
+func (a CatalogsAPI) ListSchemas(catalogName string) ([]SchemaInfo, error) {
+	var sl schemasList
+	err := a.client.Get(a.context, "/unity-catalog/schemas", map[string]string{
+		"catalog_name": catalogName,
+	}, &sl)
+	return sl.Schemas, err
+}
@@ -0,0 +1,48 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceCurrentMetastore returns information about metastore, assigned to the current workspace
+func DataSourceCurrentMetastore() *schema.Resource {
+	s := common.StructToSchema(MetastoreInfo{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["workspace_id"] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+		s["default_catalog_name"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData,
+			m interface{}) diag.Diagnostics {
+			metastoresAPI := NewMetastoresAPI(ctx, m)
+			assignment, err := metastoresAPI.CurrentAssignment()
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			metastore, err := metastoresAPI.Get(assignment.MetastoreID)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			err = common.StructToData(metastore, s, d)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			d.Set("workspace_id", assignment.WorkspaceID)
+			d.Set("default_catalog_name", assignment.DefaultCatalogName)
+			d.SetId(metastore.MetastoreID)
+			return nil
+		},
+	}
+}
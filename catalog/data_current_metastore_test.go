@@ -0,0 +1,67 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceCurrentMetastore(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/current-metastore-assignment",
+				Response: MetastoreAssignment{
+					WorkspaceID:        123,
+					MetastoreID:        "abc",
+					DefaultCatalogName: "main",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/metastores/abc",
+				Response: MetastoreInfo{
+					MetastoreID: "abc",
+					Name:        "primary",
+					Region:      "us-east-1",
+					Owner:       "admins",
+					StorageRoot: "s3://bucket/root",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceCurrentMetastore(),
+		ID:          ".",
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "primary", d.Get("name"))
+	assert.Equal(t, "us-east-1", d.Get("region"))
+	assert.Equal(t, "admins", d.Get("owner"))
+	assert.Equal(t, 123, d.Get("workspace_id"))
+	assert.Equal(t, "main", d.Get("default_catalog_name"))
+}
+
+func TestDataSourceCurrentMetastore_NotAssigned(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/current-metastore-assignment",
+				Status:   404,
+				Response: map[string]string{
+					"error_code": "METASTORE_DOES_NOT_EXIST",
+					"message":    "No metastore assigned for the current workspace.",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceCurrentMetastore(),
+		ID:          ".",
+	}.ExpectError(t, "No metastore assigned for the current workspace.")
+}
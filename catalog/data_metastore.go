@@ -0,0 +1,47 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceMetastore finds Unity Catalog metastore by its name or ID
+func DataSourceMetastore() *schema.Resource {
+	s := common.StructToSchema(MetastoreInfo{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["name"].ExactlyOneOf = []string{"name", "metastore_id"}
+		s["metastore_id"].ExactlyOneOf = []string{"name", "metastore_id"}
+		s["account_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData,
+			m interface{}) diag.Diagnostics {
+			var metastore MetastoreInfo
+			var err error
+			metastoresAPI := NewMetastoresAPI(ctx, m)
+			if id, ok := d.GetOk("metastore_id"); ok {
+				metastore, err = metastoresAPI.Get(id.(string))
+			} else {
+				metastore, err = metastoresAPI.GetByName(
+					d.Get("account_id").(string), d.Get("name").(string))
+			}
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			err = common.StructToData(metastore, s, d)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			d.SetId(metastore.MetastoreID)
+			return nil
+		},
+	}
+}
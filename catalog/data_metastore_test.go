@@ -0,0 +1,108 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceMetastore_ByName(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/metastores",
+				Response: metastoresList{
+					Metastores: []MetastoreInfo{
+						{
+							MetastoreID: "abc",
+							Name:        "primary",
+						},
+						{
+							MetastoreID: "def",
+							Name:        "secondary",
+							Region:      "eu-west-1",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMetastore(),
+		ID:          ".",
+		HCL:         `name = "secondary"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "def", d.Id())
+	assert.Equal(t, "eu-west-1", d.Get("region"))
+}
+
+func TestDataSourceMetastore_ByNameInAccount(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/acc/metastores",
+				Response: metastoresList{
+					Metastores: []MetastoreInfo{
+						{
+							MetastoreID: "abc",
+							Name:        "primary",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMetastore(),
+		ID:          ".",
+		HCL: `
+		account_id = "acc"
+		name = "primary"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+}
+
+func TestDataSourceMetastore_ByID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/metastores/abc",
+				Response: MetastoreInfo{
+					MetastoreID: "abc",
+					Name:        "primary",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMetastore(),
+		ID:          ".",
+		HCL:         `metastore_id = "abc"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "primary", d.Get("name"))
+}
+
+func TestDataSourceMetastore_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/metastores",
+				Response: metastoresList{},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMetastore(),
+		ID:          ".",
+		HCL:         `name = "primary"`,
+	}.ExpectError(t, "metastore primary not found")
+}
@@ -0,0 +1,67 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type modelVersionData struct {
+	Name            string   `json:"name"`
+	Alias           string   `json:"alias,omitempty"`
+	Version         int      `json:"version,omitempty" tf:"computed"`
+	Status          string   `json:"status,omitempty" tf:"computed"`
+	Source          string   `json:"source,omitempty" tf:"computed"`
+	RunID           string   `json:"run_id,omitempty" tf:"computed"`
+	StorageLocation string   `json:"storage_location,omitempty" tf:"computed"`
+	Comment         string   `json:"comment,omitempty" tf:"computed"`
+	Aliases         []string `json:"aliases,omitempty" tf:"computed"`
+}
+
+// DataSourceModelVersion resolves the latest or aliased version of Unity Catalog registered model
+func DataSourceModelVersion() *schema.Resource {
+	s := common.StructToSchema(modelVersionData{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData,
+			m interface{}) diag.Diagnostics {
+			var data modelVersionData
+			err := common.DataToStructPointer(d, s, &data)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			versionsAPI := NewModelVersionsAPI(ctx, m)
+			var mv ModelVersionInfo
+			if data.Alias != "" {
+				mv, err = versionsAPI.GetByAlias(data.Name, data.Alias)
+			} else {
+				mv, err = versionsAPI.Latest(data.Name)
+			}
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			data.Version = mv.Version
+			data.Status = mv.Status
+			data.Source = mv.Source
+			data.RunID = mv.RunID
+			data.StorageLocation = mv.StorageLocation
+			data.Comment = mv.Comment
+			data.Aliases = []string{}
+			for _, alias := range mv.Aliases {
+				data.Aliases = append(data.Aliases, alias.AliasName)
+			}
+			err = common.StructToData(data, s, d)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			d.SetId(fmt.Sprintf("%s/%d", data.Name, mv.Version))
+			return nil
+		},
+	}
+}
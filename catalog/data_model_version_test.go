@@ -0,0 +1,125 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceModelVersion_Alias(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/models/main.default.churn/aliases/champion",
+				Response: ModelVersionInfo{
+					ModelName:       "churn",
+					CatalogName:     "main",
+					SchemaName:      "default",
+					Version:         3,
+					Status:          "READY",
+					StorageLocation: "s3://bucket/models/churn/3",
+					Aliases: []ModelAlias{
+						{AliasName: "champion", VersionNum: 3},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceModelVersion(),
+		ID:          ".",
+		HCL: `
+		name = "main.default.churn"
+		alias = "champion"
+		`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "main.default.churn/3", d.Id())
+	assert.Equal(t, 3, d.Get("version"))
+	assert.Equal(t, "champion", d.Get("aliases.0"))
+	assert.Equal(t, "s3://bucket/models/churn/3", d.Get("storage_location"))
+}
+
+func TestDataSourceModelVersion_Latest(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/models/main.default.churn/versions?",
+				Response: modelVersionList{
+					ModelVersions: []ModelVersionInfo{
+						{Version: 1, Status: "READY"},
+						{Version: 2, Status: "READY"},
+					},
+					NextPageToken: "next",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/models/main.default.churn/versions?page_token=next",
+				Response: modelVersionList{
+					ModelVersions: []ModelVersionInfo{
+						{Version: 3, Status: "FAILED_REGISTRATION"},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceModelVersion(),
+		ID:          ".",
+		HCL:         `name = "main.default.churn"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "main.default.churn/2", d.Id())
+	assert.Equal(t, 2, d.Get("version"))
+	assert.Equal(t, "READY", d.Get("status"))
+}
+
+func TestDataSourceModelVersion_NoReadyVersions(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/models/main.default.churn/versions?",
+				Response: modelVersionList{
+					ModelVersions: []ModelVersionInfo{
+						{Version: 1, Status: "PENDING_REGISTRATION"},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceModelVersion(),
+		ID:          ".",
+		HCL:         `name = "main.default.churn"`,
+	}.ExpectError(t, "registered model main.default.churn has no ready versions")
+}
+
+func TestDataSourceModelVersion_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/models/main.default.churn/aliases/champion",
+				Status:   404,
+				Response: map[string]string{
+					"error_code": "RESOURCE_DOES_NOT_EXIST",
+					"message":    "Alias champion does not exist.",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceModelVersion(),
+		ID:          ".",
+		HCL: `
+		name = "main.default.churn"
+		alias = "champion"
+		`,
+	}.ExpectError(t, "Alias champion does not exist.")
+}
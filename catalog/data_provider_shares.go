@@ -0,0 +1,41 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceProviderShares lists shares, exposed by Delta Sharing provider
+func DataSourceProviderShares() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"shares": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			providerName := d.Get("provider_name").(string)
+			shares, err := NewProvidersAPI(ctx, m).ListShares(providerName)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			names := []string{}
+			for _, share := range shares {
+				names = append(names, share.Name)
+			}
+			// nolint
+			d.Set("shares", names)
+			d.SetId(providerName)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceProviderShares(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/providers/partner/shares",
+				Response: providerSharesList{
+					Shares: []ProviderShare{
+						{Name: "sales"},
+						{Name: "marketing"},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceProviderShares(),
+		ID:          ".",
+		HCL:         `provider_name = "partner"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "partner", d.Id())
+	assert.Equal(t, 2, d.Get("shares.#"))
+}
@@ -0,0 +1,33 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceTable returns information about Unity Catalog table, including its columns
+func DataSourceTable() *schema.Resource {
+	s := common.StructToSchema(TableInfo{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData,
+			m interface{}) diag.Diagnostics {
+			table, err := NewTablesAPI(ctx, m).Get(d.Get("name").(string))
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			err = common.StructToData(table, s, d)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			d.SetId(table.FullName)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceTable(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/tables/main.default.events",
+				Response: TableInfo{
+					FullName:         "main.default.events",
+					Name:             "events",
+					CatalogName:      "main",
+					SchemaName:       "default",
+					TableType:        "EXTERNAL",
+					DataSourceFormat: "DELTA",
+					StorageLocation:  "s3://bucket/events",
+					Columns: []ColumnInfo{
+						{
+							Name:     "id",
+							Position: 0,
+							TypeName: "LONG",
+							TypeText: "bigint",
+						},
+						{
+							Name:     "payload",
+							Position: 1,
+							TypeName: "STRING",
+							TypeText: "string",
+							Nullable: true,
+							Comment:  "raw event",
+						},
+					},
+					Properties: map[string]string{
+						"delta.minReaderVersion": "1",
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceTable(),
+		ID:          ".",
+		HCL:         `name = "main.default.events"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "main.default.events", d.Id())
+	assert.Equal(t, "main.default.events", d.Get("name"))
+	assert.Equal(t, "events", d.Get("table_name"))
+	assert.Equal(t, "s3://bucket/events", d.Get("storage_location"))
+	assert.Equal(t, 2, d.Get("columns.#"))
+	assert.Equal(t, "payload", d.Get("columns.1.name"))
+	assert.Equal(t, "string", d.Get("columns.1.type_text"))
+	assert.Equal(t, true, d.Get("columns.1.nullable"))
+	assert.Equal(t, "raw event", d.Get("columns.1.comment"))
+	assert.Equal(t, "1", d.Get("properties").(map[string]interface{})["delta.minReaderVersion"])
+}
+
+func TestDataSourceTable_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/tables/main.default.events",
+				Status:   404,
+				Response: map[string]string{
+					"error_code": "TABLE_DOES_NOT_EXIST",
+					"message":    "Table 'main.default.events' does not exist.",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceTable(),
+		ID:          ".",
+		HCL:         `name = "main.default.events"`,
+	}.ExpectError(t, "Table 'main.default.events' does not exist.")
+}
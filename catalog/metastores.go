@@ -0,0 +1,93 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+)
+
+// NewMetastoresAPI creates MetastoresAPI instance from provider meta
+func NewMetastoresAPI(ctx context.Context, m interface{}) MetastoresAPI {
+	return MetastoresAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// MetastoresAPI exposes the Unity Catalog Metastores API
+type MetastoresAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// MetastoreInfo contains information about Unity Catalog metastore
+type MetastoreInfo struct {
+	MetastoreID       string `json:"metastore_id,omitempty" tf:"computed"`
+	Name              string `json:"name,omitempty" tf:"computed"`
+	StorageRoot       string `json:"storage_root,omitempty" tf:"computed"`
+	Owner             string `json:"owner,omitempty" tf:"computed"`
+	Region            string `json:"region,omitempty" tf:"computed"`
+	Cloud             string `json:"cloud,omitempty" tf:"computed"`
+	GlobalMetastoreID string `json:"global_metastore_id,omitempty" tf:"computed"`
+	DeltaSharingScope string `json:"delta_sharing_scope,omitempty" tf:"computed"`
+	CreatedAt         int64  `json:"created_at,omitempty" tf:"computed"`
+	CreatedBy         string `json:"created_by,omitempty" tf:"computed"`
+	UpdatedAt         int64  `json:"updated_at,omitempty" tf:"computed"`
+	UpdatedBy         string `json:"updated_by,omitempty" tf:"computed"`
+}
+
+// MetastoreAssignment links workspace with a metastore
+type MetastoreAssignment struct {
+	WorkspaceID        int64  `json:"workspace_id"`
+	MetastoreID        string `json:"metastore_id"`
+	DefaultCatalogName string `json:"default_catalog_name,omitempty"`
+}
+
+type metastoresList struct {
+	Metastores []MetastoreInfo `json:"metastores"`
+}
+
+// CurrentAssignment returns metastore assignment of the current workspace
+func (a MetastoresAPI) CurrentAssignment() (ma MetastoreAssignment, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/current-metastore-assignment", nil, &ma)
+	return
+}
+
+// Get returns metastore by its ID
+func (a MetastoresAPI) Get(id string) (mi MetastoreInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/metastores/"+id, nil, &mi)
+	return
+}
+
+// List returns metastores visible from the current workspace
+func (a MetastoresAPI) List() ([]MetastoreInfo, error) {
+	var ml metastoresList
+	err := a.client.Get(a.context, "/unity-catalog/metastores", nil, &ml)
+	return ml.Metastores, err
+}
+
+// ListByAccount returns all metastores within given Databricks account
+func (a MetastoresAPI) ListByAccount(accountID string) ([]MetastoreInfo, error) {
+	var ml metastoresList
+	err := a.client.Get(a.context, fmt.Sprintf("/accounts/%s/metastores", accountID), nil, &ml)
+	return ml.Metastores, err
+}
+
+// GetByName finds metastore by name, either within an account or within
+// metastores visible from the current workspace
+func (a MetastoresAPI) GetByName(accountID, name string) (mi MetastoreInfo, err error) {
+	var metastores []MetastoreInfo
+	if accountID != "" {
+		metastores, err = a.ListByAccount(accountID)
+	} else {
+		metastores, err = a.List()
+	}
+	if err != nil {
+		return
+	}
+	for _, v := range metastores {
+		if v.Name == name {
+			return v, nil
+		}
+	}
+	err = common.NotFound(fmt.Sprintf("metastore %s not found", name))
+	return
+}
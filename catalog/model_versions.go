@@ -0,0 +1,86 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+)
+
+// NewModelVersionsAPI creates ModelVersionsAPI instance from provider meta
+func NewModelVersionsAPI(ctx context.Context, m interface{}) ModelVersionsAPI {
+	return ModelVersionsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// ModelVersionsAPI exposes versions of Unity Catalog registered models
+type ModelVersionsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// ModelAlias points a human-readable name, like `champion`, to a model version
+type ModelAlias struct {
+	AliasName  string `json:"alias_name"`
+	VersionNum int    `json:"version_num"`
+}
+
+// ModelVersionInfo describes a single version of Unity Catalog registered model
+type ModelVersionInfo struct {
+	ModelName       string       `json:"model_name"`
+	CatalogName     string       `json:"catalog_name"`
+	SchemaName      string       `json:"schema_name"`
+	Version         int          `json:"version"`
+	Status          string       `json:"status,omitempty"`
+	Source          string       `json:"source,omitempty"`
+	RunID           string       `json:"run_id,omitempty"`
+	StorageLocation string       `json:"storage_location,omitempty"`
+	Comment         string       `json:"comment,omitempty"`
+	Aliases         []ModelAlias `json:"aliases,omitempty"`
+}
+
+type modelVersionList struct {
+	ModelVersions []ModelVersionInfo `json:"model_versions"`
+	NextPageToken string             `json:"next_page_token,omitempty"`
+}
+
+// GetByAlias returns model version, that alias points to
+func (a ModelVersionsAPI) GetByAlias(fullName, alias string) (mv ModelVersionInfo, err error) {
+	err = a.client.Get(a.context, fmt.Sprintf("/unity-catalog/models/%s/aliases/%s",
+		fullName, alias), nil, &mv)
+	return
+}
+
+// List returns all versions of registered model by its full name, e.g. `main.default.churn`
+func (a ModelVersionsAPI) List(fullName string) (versions []ModelVersionInfo, err error) {
+	request := map[string]string{}
+	for {
+		var page modelVersionList
+		err = a.client.Get(a.context, fmt.Sprintf("/unity-catalog/models/%s/versions",
+			fullName), request, &page)
+		if err != nil {
+			return
+		}
+		versions = append(versions, page.ModelVersions...)
+		if page.NextPageToken == "" {
+			return
+		}
+		request["page_token"] = page.NextPageToken
+	}
+}
+
+// Latest returns the most recent version of registered model, that is ready to be served
+func (a ModelVersionsAPI) Latest(fullName string) (mv ModelVersionInfo, err error) {
+	versions, err := a.List(fullName)
+	if err != nil {
+		return
+	}
+	for _, v := range versions {
+		if v.Status == "READY" && v.Version > mv.Version {
+			mv = v
+		}
+	}
+	if mv.Version == 0 {
+		err = fmt.Errorf("registered model %s has no ready versions", fullName)
+	}
+	return
+}
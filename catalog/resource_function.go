@@ -0,0 +1,171 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewFunctionsAPI creates FunctionsAPI instance from provider meta
+func NewFunctionsAPI(ctx context.Context, m interface{}) FunctionsAPI {
+	return FunctionsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// FunctionsAPI exposes the Unity Catalog Functions API
+type FunctionsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// FunctionParameterInfo describes single input parameter of a function
+type FunctionParameterInfo struct {
+	Name             string `json:"name"`
+	TypeText         string `json:"type_text"`
+	TypeName         string `json:"type_name"`
+	Position         int    `json:"position,omitempty" tf:"computed"`
+	Comment          string `json:"comment,omitempty"`
+	ParameterDefault string `json:"parameter_default,omitempty"`
+}
+
+// FunctionParameterInfos wraps list of function parameters
+type FunctionParameterInfos struct {
+	Parameters []FunctionParameterInfo `json:"parameters"`
+}
+
+// FunctionInfo describes SQL or Python user-defined function in Unity Catalog
+type FunctionInfo struct {
+	Name              string                  `json:"name"`
+	CatalogName       string                  `json:"catalog_name"`
+	SchemaName        string                  `json:"schema_name"`
+	InputParams       *FunctionParameterInfos `json:"input_params,omitempty"`
+	DataType          string                  `json:"data_type"`
+	FullDataType      string                  `json:"full_data_type"`
+	RoutineBody       string                  `json:"routine_body"`
+	RoutineDefinition string                  `json:"routine_definition"`
+	ExternalLanguage  string                  `json:"external_language,omitempty"`
+	ParameterStyle    string                  `json:"parameter_style,omitempty" tf:"default:S"`
+	IsDeterministic   bool                    `json:"is_deterministic,omitempty"`
+	IsNullCall        bool                    `json:"is_null_call,omitempty"`
+	SQLDataAccess     string                  `json:"sql_data_access,omitempty" tf:"default:CONTAINS_SQL"`
+	SecurityType      string                  `json:"security_type,omitempty" tf:"default:DEFINER"`
+	SpecificName      string                  `json:"specific_name,omitempty" tf:"computed"`
+	Comment           string                  `json:"comment,omitempty"`
+	Owner             string                  `json:"owner,omitempty" tf:"computed"`
+	FullName          string                  `json:"full_name,omitempty" tf:"computed"`
+}
+
+type createFunction struct {
+	FunctionInfo FunctionInfo `json:"function_info"`
+}
+
+// Create registers new function
+func (a FunctionsAPI) Create(fi FunctionInfo) (created FunctionInfo, err error) {
+	err = a.client.Post(a.context, "/unity-catalog/functions", createFunction{fi}, &created)
+	return
+}
+
+// Get returns function by its full name, e.g. `main.default.add_one`
+func (a FunctionsAPI) Get(fullName string) (fi FunctionInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/functions/"+fullName, nil, &fi)
+	return
+}
+
+type functionsList struct {
+	Functions []FunctionInfo `json:"functions"`
+}
+
+// List returns functions of the schema
+func (a FunctionsAPI) List(catalogName, schemaName string) ([]FunctionInfo, error) {
+	var fl functionsList
+	err := a.client.Get(a.context, "/unity-catalog/functions", map[string]string{
+		"catalog_name": catalogName,
+		"schema_name":  schemaName,
+	}, &fl)
+	return fl.Functions, err
+}
+
+// UpdateOwner changes owner of the function, the only mutable property
+func (a FunctionsAPI) UpdateOwner(fullName, owner string) error {
+	return a.client.Patch(a.context, "/unity-catalog/functions/"+fullName, map[string]string{
+		"owner": owner,
+	})
+}
+
+// Delete removes function
+func (a FunctionsAPI) Delete(fullName string) error {
+	return a.client.Delete(a.context, "/unity-catalog/functions/"+fullName, nil)
+}
+
+// ResourceFunction manages user-defined functions in Unity Catalog
+func ResourceFunction() *schema.Resource {
+	s := common.StructToSchema(FunctionInfo{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		// function definitions are immutable, only owner could be changed
+		var forceNew func(s map[string]*schema.Schema)
+		forceNew = func(s map[string]*schema.Schema) {
+			for k, v := range s {
+				if k == "owner" || v.Computed && !v.Optional {
+					continue
+				}
+				v.ForceNew = true
+				if nested, ok := v.Elem.(*schema.Resource); ok {
+					forceNew(nested.Schema)
+				}
+			}
+		}
+		forceNew(s)
+		// nolint
+		s["routine_body"].ValidateFunc = validation.StringInSlice([]string{"SQL", "EXTERNAL"}, false)
+		// nolint
+		s["sql_data_access"].ValidateFunc = validation.StringInSlice([]string{
+			"CONTAINS_SQL", "READS_SQL_DATA", "NO_SQL"}, false)
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var fi FunctionInfo
+			if err := common.DataToStructPointer(d, s, &fi); err != nil {
+				return err
+			}
+			if fi.RoutineBody == "EXTERNAL" && fi.ExternalLanguage == "" {
+				return fmt.Errorf("external_language is required for EXTERNAL routine_body")
+			}
+			if fi.InputParams != nil {
+				for i := range fi.InputParams.Parameters {
+					fi.InputParams.Parameters[i].Position = i
+				}
+			}
+			functionsAPI := NewFunctionsAPI(ctx, c)
+			created, err := functionsAPI.Create(fi)
+			if err != nil {
+				return err
+			}
+			fullName := created.FullName
+			if fullName == "" {
+				fullName = fmt.Sprintf("%s.%s.%s", fi.CatalogName, fi.SchemaName, fi.Name)
+			}
+			d.SetId(fullName)
+			if fi.Owner != "" && fi.Owner != created.Owner {
+				return functionsAPI.UpdateOwner(fullName, fi.Owner)
+			}
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			fi, err := NewFunctionsAPI(ctx, c).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(fi, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewFunctionsAPI(ctx, c).UpdateOwner(d.Id(), d.Get("owner").(string))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewFunctionsAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
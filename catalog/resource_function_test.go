@@ -0,0 +1,226 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceFunctionCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceFunction())
+}
+
+func TestResourceFunctionCreate(t *testing.T) {
+	fi := FunctionInfo{
+		Name:        "add_one",
+		CatalogName: "main",
+		SchemaName:  "default",
+		InputParams: &FunctionParameterInfos{
+			Parameters: []FunctionParameterInfo{
+				{
+					Name:     "x",
+					TypeText: "int",
+					TypeName: "INT",
+				},
+				{
+					Name:             "y",
+					TypeText:         "int",
+					TypeName:         "INT",
+					Position:         1,
+					ParameterDefault: "1",
+				},
+			},
+		},
+		DataType:          "INT",
+		FullDataType:      "int",
+		RoutineBody:       "SQL",
+		RoutineDefinition: "x + y",
+		ParameterStyle:    "S",
+		IsDeterministic:   true,
+		SQLDataAccess:     "CONTAINS_SQL",
+		SecurityType:      "DEFINER",
+		Comment:           "adds numbers",
+	}
+	created := fi
+	created.FullName = "main.default.add_one"
+	created.Owner = "me"
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/unity-catalog/functions",
+				ExpectedRequest: createFunction{
+					FunctionInfo: fi,
+				},
+				Response: created,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/functions/main.default.add_one",
+				Response: created,
+			},
+		},
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name = "add_one"
+		catalog_name = "main"
+		schema_name = "default"
+		input_params {
+			parameters {
+				name = "x"
+				type_text = "int"
+				type_name = "INT"
+			}
+			parameters {
+				name = "y"
+				type_text = "int"
+				type_name = "INT"
+				parameter_default = "1"
+			}
+		}
+		data_type = "INT"
+		full_data_type = "int"
+		routine_body = "SQL"
+		routine_definition = "x + y"
+		is_deterministic = true
+		comment = "adds numbers"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "main.default.add_one", d.Id())
+	assert.Equal(t, "me", d.Get("owner"))
+	assert.Equal(t, 1, d.Get("input_params.0.parameters.1.position"))
+}
+
+func TestResourceFunctionCreate_WithOwner(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/unity-catalog/functions",
+				Response: FunctionInfo{
+					FullName: "main.default.hello",
+					Owner:    "me",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/unity-catalog/functions/main.default.hello",
+				ExpectedRequest: map[string]string{
+					"owner": "data engineers",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/functions/main.default.hello",
+				Response: FunctionInfo{
+					Name:              "hello",
+					CatalogName:       "main",
+					SchemaName:        "default",
+					DataType:          "STRING",
+					FullDataType:      "string",
+					RoutineBody:       "EXTERNAL",
+					RoutineDefinition: "return 'hello'",
+					ExternalLanguage:  "Python",
+					FullName:          "main.default.hello",
+					Owner:             "data engineers",
+				},
+			},
+		},
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name = "hello"
+		catalog_name = "main"
+		schema_name = "default"
+		data_type = "STRING"
+		full_data_type = "string"
+		routine_body = "EXTERNAL"
+		routine_definition = "return 'hello'"
+		external_language = "Python"
+		owner = "data engineers"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "data engineers", d.Get("owner"))
+}
+
+func TestResourceFunctionCreate_ExternalWithoutLanguage(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceFunction(),
+		Create:   true,
+		HCL: `
+		name = "hello"
+		catalog_name = "main"
+		schema_name = "default"
+		data_type = "STRING"
+		full_data_type = "string"
+		routine_body = "EXTERNAL"
+		routine_definition = "return 'hello'"`,
+	}.ExpectError(t, "external_language is required for EXTERNAL routine_body")
+}
+
+func TestResourceFunctionUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/unity-catalog/functions/main.default.hello",
+				ExpectedRequest: map[string]string{
+					"owner": "new owner",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/functions/main.default.hello",
+				Response: FunctionInfo{
+					Name:              "hello",
+					CatalogName:       "main",
+					SchemaName:        "default",
+					DataType:          "STRING",
+					FullDataType:      "string",
+					RoutineBody:       "SQL",
+					RoutineDefinition: "'hello'",
+					Owner:             "new owner",
+				},
+			},
+		},
+		Resource: ResourceFunction(),
+		Update:   true,
+		ID:       "main.default.hello",
+		InstanceState: map[string]string{
+			"name":               "hello",
+			"catalog_name":       "main",
+			"schema_name":        "default",
+			"data_type":          "STRING",
+			"full_data_type":     "string",
+			"routine_body":       "SQL",
+			"routine_definition": "'hello'",
+			"owner":              "old owner",
+		},
+		HCL: `
+		name = "hello"
+		catalog_name = "main"
+		schema_name = "default"
+		data_type = "STRING"
+		full_data_type = "string"
+		routine_body = "SQL"
+		routine_definition = "'hello'"
+		owner = "new owner"`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceFunctionDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/unity-catalog/functions/main.default.hello",
+			},
+		},
+		Resource: ResourceFunction(),
+		Delete:   true,
+		ID:       "main.default.hello",
+	}.ApplyNoError(t)
+}
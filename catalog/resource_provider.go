@@ -0,0 +1,116 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewProvidersAPI creates ProvidersAPI instance from provider meta
+func NewProvidersAPI(ctx context.Context, m interface{}) ProvidersAPI {
+	return ProvidersAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// ProvidersAPI exposes the Delta Sharing Providers API
+type ProvidersAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// ProviderInfo describes Delta Sharing provider, as seen from the recipient side
+type ProviderInfo struct {
+	Name                string `json:"name"`
+	Comment             string `json:"comment,omitempty"`
+	AuthenticationType  string `json:"authentication_type"`
+	RecipientProfileStr string `json:"recipient_profile_str"`
+	Owner               string `json:"owner,omitempty" tf:"computed"`
+}
+
+// ProviderShare is a share, exposed by Delta Sharing provider
+type ProviderShare struct {
+	Name string `json:"name"`
+}
+
+type providerSharesList struct {
+	Shares []ProviderShare `json:"shares"`
+}
+
+// Create registers Delta Sharing provider
+func (a ProvidersAPI) Create(pi ProviderInfo) error {
+	return a.client.Post(a.context, "/unity-catalog/providers", pi, nil)
+}
+
+// Get returns Delta Sharing provider by name
+func (a ProvidersAPI) Get(name string) (pi ProviderInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/providers/"+name, nil, &pi)
+	return
+}
+
+// Update changes comment or recipient profile of Delta Sharing provider
+func (a ProvidersAPI) Update(pi ProviderInfo) error {
+	return a.client.Patch(a.context, "/unity-catalog/providers/"+pi.Name, map[string]string{
+		"comment":               pi.Comment,
+		"recipient_profile_str": pi.RecipientProfileStr,
+	})
+}
+
+// Delete removes Delta Sharing provider
+func (a ProvidersAPI) Delete(name string) error {
+	return a.client.Delete(a.context, "/unity-catalog/providers/"+name, nil)
+}
+
+// ListShares returns shares, that provider exposes to the current metastore
+func (a ProvidersAPI) ListShares(name string) ([]ProviderShare, error) {
+	var psl providerSharesList
+	err := a.client.Get(a.context, "/unity-catalog/providers/"+name+"/shares", nil, &psl)
+	return psl.Shares, err
+}
+
+// ResourceProvider manages Delta Sharing providers
+func ResourceProvider() *schema.Resource {
+	s := common.StructToSchema(ProviderInfo{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["name"].ForceNew = true
+		s["authentication_type"].ForceNew = true
+		// nolint
+		s["authentication_type"].ValidateFunc = validation.StringInSlice([]string{"TOKEN"}, false)
+		s["recipient_profile_str"].Sensitive = true
+		s["recipient_profile_str"].ValidateFunc = validation.StringIsJSON
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var pi ProviderInfo
+			if err := common.DataToStructPointer(d, s, &pi); err != nil {
+				return err
+			}
+			if err := NewProvidersAPI(ctx, c).Create(pi); err != nil {
+				return err
+			}
+			d.SetId(pi.Name)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			pi, err := NewProvidersAPI(ctx, c).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			// recipient profile holds bearer token and is never returned back
+			pi.RecipientProfileStr = d.Get("recipient_profile_str").(string)
+			return common.StructToData(pi, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var pi ProviderInfo
+			if err := common.DataToStructPointer(d, s, &pi); err != nil {
+				return err
+			}
+			return NewProvidersAPI(ctx, c).Update(pi)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewProvidersAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
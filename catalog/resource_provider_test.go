@@ -0,0 +1,141 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecipientProfile = `{"shareCredentialsVersion":1,"bearerToken":"dapi...","endpoint":"https://sharing.delta.io/delta-sharing/"}`
+
+func TestResourceProviderCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceProvider())
+}
+
+func TestResourceProviderCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/unity-catalog/providers",
+				ExpectedRequest: ProviderInfo{
+					Name:                "partner",
+					Comment:             "from partner",
+					AuthenticationType:  "TOKEN",
+					RecipientProfileStr: testRecipientProfile,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/providers/partner",
+				Response: ProviderInfo{
+					Name:               "partner",
+					Comment:            "from partner",
+					AuthenticationType: "TOKEN",
+					Owner:              "admins",
+				},
+			},
+		},
+		Resource: ResourceProvider(),
+		Create:   true,
+		State: map[string]interface{}{
+			"name":                  "partner",
+			"comment":               "from partner",
+			"authentication_type":   "TOKEN",
+			"recipient_profile_str": testRecipientProfile,
+		},
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "partner", d.Id())
+	assert.Equal(t, "admins", d.Get("owner"))
+	assert.Contains(t, d.Get("recipient_profile_str"), "bearerToken")
+}
+
+func TestResourceProviderCreate_InvalidAuthenticationType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceProvider(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		authentication_type = "PASSWORD"
+		recipient_profile_str = "{}"`,
+	}.ExpectError(t, "invalid config supplied. [authentication_type] "+
+		"expected authentication_type to be one of [TOKEN], got PASSWORD")
+}
+
+func TestResourceProviderRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/providers/partner",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Provider partner does not exist",
+				},
+			},
+		},
+		Resource: ResourceProvider(),
+		Read:     true,
+		Removed:  true,
+		ID:       "partner",
+	}.ApplyNoError(t)
+}
+
+func TestResourceProviderUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/unity-catalog/providers/partner",
+				ExpectedRequest: map[string]string{
+					"comment":               "changed",
+					"recipient_profile_str": "{}",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/providers/partner",
+				Response: ProviderInfo{
+					Name:               "partner",
+					Comment:            "changed",
+					AuthenticationType: "TOKEN",
+				},
+			},
+		},
+		Resource: ResourceProvider(),
+		Update:   true,
+		ID:       "partner",
+		InstanceState: map[string]string{
+			"name":                  "partner",
+			"comment":               "original",
+			"authentication_type":   "TOKEN",
+			"recipient_profile_str": "{}",
+		},
+		HCL: `
+		name = "partner"
+		comment = "changed"
+		authentication_type = "TOKEN"
+		recipient_profile_str = "{}"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "changed", d.Get("comment"))
+}
+
+func TestResourceProviderDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/unity-catalog/providers/partner",
+			},
+		},
+		Resource: ResourceProvider(),
+		Delete:   true,
+		ID:       "partner",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,185 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// recipientIPAccessListMaxEntries is the limit of allowed IP addresses per recipient
+const recipientIPAccessListMaxEntries = 100
+
+// NewRecipientsAPI creates RecipientsAPI instance from provider meta
+func NewRecipientsAPI(ctx context.Context, m interface{}) RecipientsAPI {
+	return RecipientsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// RecipientsAPI exposes the Delta Sharing Recipients API
+type RecipientsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// IPAccessList restricts networks, from which recipient can access shared data
+type IPAccessList struct {
+	AllowedIPAddresses []string `json:"allowed_ip_addresses"`
+}
+
+// RecipientToken is a bearer token of recipient, that is activated through activation link
+type RecipientToken struct {
+	ID             string `json:"id,omitempty" tf:"computed"`
+	ActivationURL  string `json:"activation_url,omitempty" tf:"computed"`
+	ExpirationTime int64  `json:"expiration_time,omitempty" tf:"computed"`
+	CreatedAt      int64  `json:"created_at,omitempty" tf:"computed"`
+	CreatedBy      string `json:"created_by,omitempty" tf:"computed"`
+	UpdatedAt      int64  `json:"updated_at,omitempty" tf:"computed"`
+	UpdatedBy      string `json:"updated_by,omitempty" tf:"computed"`
+}
+
+// RecipientInfo describes Delta Sharing recipient, as seen from the provider side
+type RecipientInfo struct {
+	Name                           string           `json:"name" tf:"force_new"`
+	Comment                        string           `json:"comment,omitempty"`
+	AuthenticationType             string           `json:"authentication_type" tf:"force_new"`
+	DataRecipientGlobalMetastoreID string           `json:"data_recipient_global_metastore_id,omitempty" tf:"force_new"`
+	IPAccessList                   *IPAccessList    `json:"ip_access_list,omitempty"`
+	Owner                          string           `json:"owner,omitempty" tf:"computed"`
+	Tokens                         []RecipientToken `json:"tokens,omitempty" tf:"computed"`
+}
+
+type rotateRecipientToken struct {
+	ExistingTokenExpireInSeconds int64 `json:"existing_token_expire_in_seconds"`
+}
+
+type recipientUpdate struct {
+	Comment      string        `json:"comment"`
+	IPAccessList *IPAccessList `json:"ip_access_list"`
+}
+
+// Create registers Delta Sharing recipient
+func (a RecipientsAPI) Create(ri RecipientInfo) error {
+	return a.client.Post(a.context, "/unity-catalog/recipients", ri, nil)
+}
+
+// Get returns Delta Sharing recipient by name
+func (a RecipientsAPI) Get(name string) (ri RecipientInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/recipients/"+name, nil, &ri)
+	return
+}
+
+// Update changes comment or IP access list of Delta Sharing recipient. Nil
+// IP access list removes all network restrictions.
+func (a RecipientsAPI) Update(ri RecipientInfo) error {
+	ipAccessList := ri.IPAccessList
+	if ipAccessList == nil {
+		ipAccessList = &IPAccessList{AllowedIPAddresses: []string{}}
+	}
+	return a.client.Patch(a.context, "/unity-catalog/recipients/"+ri.Name, recipientUpdate{
+		Comment:      ri.Comment,
+		IPAccessList: ipAccessList,
+	})
+}
+
+// RotateToken issues new activation token and expires existing token in the given
+// number of seconds. Zero expires existing token immediately.
+func (a RecipientsAPI) RotateToken(name string, existingTokenExpireInSeconds int64) error {
+	return a.client.Post(a.context, "/unity-catalog/recipients/"+name+"/rotate-token", rotateRecipientToken{
+		ExistingTokenExpireInSeconds: existingTokenExpireInSeconds,
+	}, nil)
+}
+
+// Delete removes Delta Sharing recipient
+func (a RecipientsAPI) Delete(name string) error {
+	return a.client.Delete(a.context, "/unity-catalog/recipients/"+name, nil)
+}
+
+// ResourceRecipient manages Delta Sharing recipients
+func ResourceRecipient() *schema.Resource {
+	s := common.StructToSchema(RecipientInfo{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		// nolint
+		s["authentication_type"].ValidateFunc = validation.StringInSlice([]string{"TOKEN", "DATABRICKS"}, false)
+		ipAccessList := s["ip_access_list"].Elem.(*schema.Resource)
+		allowed := ipAccessList.Schema["allowed_ip_addresses"]
+		allowed.MinItems = 1
+		allowed.MaxItems = recipientIPAccessListMaxEntries
+		allowed.Elem = &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.Any(validation.IsIPv4Address, validation.IsCIDR),
+		}
+		s["existing_token_expire_in_seconds"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+		s["keepers"] = &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			_, ipRestricted := d.GetOk("ip_access_list")
+			if ipRestricted && d.Get("authentication_type") != "TOKEN" {
+				return fmt.Errorf("ip_access_list is only supported for TOKEN authentication type")
+			}
+			_, hasKeepers := d.GetOk("keepers")
+			if hasKeepers && d.Get("authentication_type") != "TOKEN" {
+				return fmt.Errorf("keepers are only supported for TOKEN authentication type")
+			}
+			if d.Id() != "" && d.HasChange("keepers") {
+				// new activation token is issued on apply
+				return d.SetNewComputed("tokens")
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ri RecipientInfo
+			if err := common.DataToStructPointer(d, s, &ri); err != nil {
+				return err
+			}
+			if err := NewRecipientsAPI(ctx, c).Create(ri); err != nil {
+				return err
+			}
+			d.SetId(ri.Name)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			ri, err := NewRecipientsAPI(ctx, c).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			if ri.IPAccessList != nil && len(ri.IPAccessList.AllowedIPAddresses) == 0 {
+				// API returns empty list for recipients without network restrictions
+				ri.IPAccessList = nil
+			}
+			return common.StructToData(ri, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var ri RecipientInfo
+			if err := common.DataToStructPointer(d, s, &ri); err != nil {
+				return err
+			}
+			recipientsAPI := NewRecipientsAPI(ctx, c)
+			if d.HasChanges("comment", "ip_access_list") {
+				if err := recipientsAPI.Update(ri); err != nil {
+					return err
+				}
+			}
+			if !d.HasChange("keepers") {
+				return nil
+			}
+			return recipientsAPI.RotateToken(ri.Name,
+				int64(d.Get("existing_token_expire_in_seconds").(int)))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewRecipientsAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
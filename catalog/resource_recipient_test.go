@@ -0,0 +1,254 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceRecipientCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceRecipient())
+}
+
+func TestResourceRecipientCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/unity-catalog/recipients",
+				ExpectedRequest: RecipientInfo{
+					Name:               "partner",
+					Comment:            "for partner",
+					AuthenticationType: "TOKEN",
+					IPAccessList: &IPAccessList{
+						AllowedIPAddresses: []string{"10.0.0.1", "192.168.1.0/24"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/recipients/partner",
+				Response: RecipientInfo{
+					Name:               "partner",
+					Comment:            "for partner",
+					AuthenticationType: "TOKEN",
+					IPAccessList: &IPAccessList{
+						AllowedIPAddresses: []string{"10.0.0.1", "192.168.1.0/24"},
+					},
+					Owner: "admins",
+					Tokens: []RecipientToken{
+						{
+							ID:            "t1",
+							ActivationURL: "https://example.com/activate",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		comment = "for partner"
+		authentication_type = "TOKEN"
+		ip_access_list {
+			allowed_ip_addresses = ["10.0.0.1", "192.168.1.0/24"]
+		}`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "partner", d.Id())
+	assert.Equal(t, "admins", d.Get("owner"))
+	assert.Equal(t, "https://example.com/activate", d.Get("tokens.0.activation_url"))
+	assert.Equal(t, 2, d.Get("ip_access_list.0.allowed_ip_addresses.#"))
+}
+
+func TestResourceRecipientCreate_InvalidIPAddress(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceRecipient(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		authentication_type = "TOKEN"
+		ip_access_list {
+			allowed_ip_addresses = ["10.0.0.300"]
+		}`,
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "invalid config supplied. [ip_access_list.#.allowed_ip_addresses.#]")
+}
+
+func TestResourceRecipientCreate_IPAccessListRequiresToken(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRecipient(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		authentication_type = "DATABRICKS"
+		data_recipient_global_metastore_id = "aws:us-west-2:abc"
+		ip_access_list {
+			allowed_ip_addresses = ["10.0.0.1"]
+		}`,
+	}.ExpectError(t, "ip_access_list is only supported for TOKEN authentication type")
+}
+
+func TestResourceRecipientUpdate_RemoveIPAccessList(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/unity-catalog/recipients/partner",
+				ExpectedRequest: recipientUpdate{
+					Comment: "for partner",
+					IPAccessList: &IPAccessList{
+						AllowedIPAddresses: []string{},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/recipients/partner",
+				Response: RecipientInfo{
+					Name:               "partner",
+					Comment:            "for partner",
+					AuthenticationType: "TOKEN",
+					IPAccessList: &IPAccessList{
+						AllowedIPAddresses: []string{},
+					},
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Update:   true,
+		ID:       "partner",
+		InstanceState: map[string]string{
+			"name":                "partner",
+			"comment":             "for partner",
+			"authentication_type": "TOKEN",
+			"ip_access_list.#":    "1",
+			"ip_access_list.0.allowed_ip_addresses.#": "1",
+			"ip_access_list.0.allowed_ip_addresses.0": "10.0.0.1",
+		},
+		HCL: `
+		name = "partner"
+		comment = "for partner"
+		authentication_type = "TOKEN"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, 0, d.Get("ip_access_list.#"))
+}
+
+func TestResourceRecipientUpdate_RotateToken(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/unity-catalog/recipients/partner/rotate-token",
+				ExpectedRequest: rotateRecipientToken{
+					ExistingTokenExpireInSeconds: 3600,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/recipients/partner",
+				Response: RecipientInfo{
+					Name:               "partner",
+					Comment:            "for partner",
+					AuthenticationType: "TOKEN",
+					Tokens: []RecipientToken{
+						{
+							ID:             "t1",
+							ActivationURL:  "https://example.com/activate/t1",
+							ExpirationTime: 1650000000000,
+						},
+						{
+							ID:            "t2",
+							ActivationURL: "https://example.com/activate/t2",
+							CreatedAt:     1640000000000,
+							CreatedBy:     "admin@example.com",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Update:   true,
+		ID:       "partner",
+		InstanceState: map[string]string{
+			"name":                "partner",
+			"comment":             "for partner",
+			"authentication_type": "TOKEN",
+			"keepers.%":           "1",
+			"keepers.rotation":    "2021-12",
+			"tokens.#":            "1",
+			"tokens.0.id":         "t1",
+		},
+		HCL: `
+		name = "partner"
+		comment = "for partner"
+		authentication_type = "TOKEN"
+		existing_token_expire_in_seconds = 3600
+		keepers = {
+			rotation = "2022-01"
+		}`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, 2, d.Get("tokens.#"))
+	assert.Equal(t, "t2", d.Get("tokens.1.id"))
+	assert.Equal(t, "admin@example.com", d.Get("tokens.1.created_by"))
+}
+
+func TestResourceRecipientDiff_KeepersRegenerateTokens(t *testing.T) {
+	diff, err := ResourceRecipient().Diff(context.Background(), &terraform.InstanceState{
+		ID: "partner",
+		Attributes: map[string]string{
+			"id":                  "partner",
+			"name":                "partner",
+			"authentication_type": "TOKEN",
+			"keepers.%":           "1",
+			"keepers.rotation":    "2021-12",
+			"tokens.#":            "1",
+			"tokens.0.id":         "t1",
+		},
+	}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "partner",
+		"authentication_type": "TOKEN",
+		"keepers": map[string]interface{}{
+			"rotation": "2022-01",
+		},
+	}), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.False(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["tokens.#"].NewComputed)
+}
+
+func TestResourceRecipientCreate_KeepersRequireToken(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRecipient(),
+		Create:   true,
+		HCL: `
+		name = "partner"
+		authentication_type = "DATABRICKS"
+		data_recipient_global_metastore_id = "aws:us-west-2:abc"
+		keepers = {
+			rotation = "2022-01"
+		}`,
+	}.ExpectError(t, "keepers are only supported for TOKEN authentication type")
+}
+
+func TestResourceRecipientDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/unity-catalog/recipients/partner",
+			},
+		},
+		Resource: ResourceRecipient(),
+		Delete:   true,
+		ID:       "partner",
+	}.ApplyNoError(t)
+}
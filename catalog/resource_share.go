@@ -0,0 +1,228 @@
+package catalog
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewSharesAPI creates SharesAPI instance from provider meta
+func NewSharesAPI(ctx context.Context, m interface{}) SharesAPI {
+	return SharesAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// SharesAPI exposes the Delta Sharing Shares API
+type SharesAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// PartitionValue is a condition on a single partition column
+type PartitionValue struct {
+	Name                 string `json:"name"`
+	Op                   string `json:"op"`
+	Value                string `json:"value,omitempty"`
+	RecipientPropertyKey string `json:"recipient_property_key,omitempty"`
+}
+
+// Partition limits shared data to partitions, that match all of its values
+type Partition struct {
+	Values []PartitionValue `json:"values" tf:"alias:value"`
+}
+
+// SharedDataObject is a table, that is shared with recipients
+type SharedDataObject struct {
+	Name                     string      `json:"name"`
+	DataObjectType           string      `json:"data_object_type"`
+	Comment                  string      `json:"comment,omitempty"`
+	SharedAs                 string      `json:"shared_as,omitempty" tf:"computed"`
+	CDFEnabled               bool        `json:"cdf_enabled,omitempty"`
+	StartVersion             int64       `json:"start_version,omitempty"`
+	HistoryDataSharingStatus string      `json:"history_data_sharing_status,omitempty" tf:"computed"`
+	Partitions               []Partition `json:"partitions,omitempty" tf:"alias:partition"`
+	Status                   string      `json:"status,omitempty" tf:"computed"`
+	AddedAt                  int64       `json:"added_at,omitempty" tf:"computed"`
+	AddedBy                  string      `json:"added_by,omitempty" tf:"computed"`
+}
+
+// ShareInfo describes Delta Sharing share with its objects
+type ShareInfo struct {
+	Name    string             `json:"name" tf:"force_new"`
+	Comment string             `json:"comment,omitempty"`
+	Objects []SharedDataObject `json:"objects,omitempty" tf:"alias:object"`
+	Owner   string             `json:"owner,omitempty" tf:"computed"`
+}
+
+// SharedDataObjectUpdate adds, removes or changes an object of the share
+type SharedDataObjectUpdate struct {
+	Action     string           `json:"action"`
+	DataObject SharedDataObject `json:"data_object"`
+}
+
+type shareUpdate struct {
+	Comment string                   `json:"comment,omitempty"`
+	Updates []SharedDataObjectUpdate `json:"updates,omitempty"`
+}
+
+// Create registers an empty Delta Sharing share
+func (a SharesAPI) Create(name, comment string) error {
+	return a.client.Post(a.context, "/unity-catalog/shares", map[string]string{
+		"name":    name,
+		"comment": comment,
+	}, nil)
+}
+
+// Get returns Delta Sharing share by name, including its objects
+func (a SharesAPI) Get(name string) (si ShareInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/shares/"+name, map[string]string{
+		"include_shared_data": "true",
+	}, &si)
+	return
+}
+
+// Update changes comment and objects of Delta Sharing share
+func (a SharesAPI) Update(name, comment string, updates []SharedDataObjectUpdate) error {
+	return a.client.Patch(a.context, "/unity-catalog/shares/"+name, shareUpdate{
+		Comment: comment,
+		Updates: updates,
+	})
+}
+
+// Delete removes Delta Sharing share
+func (a SharesAPI) Delete(name string) error {
+	return a.client.Delete(a.context, "/unity-catalog/shares/"+name, nil)
+}
+
+// sharedObjectDiff returns updates, that turn objects of the share from old to new
+func sharedObjectDiff(old, new []SharedDataObject) (updates []SharedDataObjectUpdate) {
+	before := map[string]SharedDataObject{}
+	for _, o := range old {
+		before[o.Name] = o
+	}
+	after := map[string]bool{}
+	for _, n := range new {
+		after[n.Name] = true
+		o, ok := before[n.Name]
+		if !ok {
+			updates = append(updates, SharedDataObjectUpdate{"ADD", n})
+			continue
+		}
+		if !reflect.DeepEqual(withoutComputed(o, n), n) {
+			updates = append(updates, SharedDataObjectUpdate{"UPDATE", n})
+		}
+	}
+	for _, o := range old {
+		if !after[o.Name] {
+			updates = append(updates, SharedDataObjectUpdate{"REMOVE", SharedDataObject{
+				Name:           o.Name,
+				DataObjectType: o.DataObjectType,
+			}})
+		}
+	}
+	return
+}
+
+// withoutComputed clears fields of existing object, that are set by the API and
+// are not configured in the new object, so that both could be compared
+func withoutComputed(o, n SharedDataObject) SharedDataObject {
+	o.Status = n.Status
+	o.AddedAt = n.AddedAt
+	o.AddedBy = n.AddedBy
+	if n.SharedAs == "" {
+		o.SharedAs = ""
+	}
+	if n.HistoryDataSharingStatus == "" {
+		o.HistoryDataSharingStatus = ""
+	}
+	return o
+}
+
+// sortObjectsLike orders objects from API in the same way, as they are configured,
+// so that the list is not shown as changed. New objects are placed at the end.
+func sortObjectsLike(objects []SharedDataObject, configured []SharedDataObject) []SharedDataObject {
+	byName := map[string]SharedDataObject{}
+	for _, o := range objects {
+		byName[o.Name] = o
+	}
+	sorted := []SharedDataObject{}
+	for _, c := range configured {
+		if o, ok := byName[c.Name]; ok {
+			sorted = append(sorted, o)
+			delete(byName, c.Name)
+		}
+	}
+	for _, o := range objects {
+		if _, ok := byName[o.Name]; ok {
+			sorted = append(sorted, o)
+		}
+	}
+	return sorted
+}
+
+// ResourceShare manages Delta Sharing shares and objects in them
+func ResourceShare() *schema.Resource {
+	s := common.StructToSchema(ShareInfo{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		object := s["object"].Elem.(*schema.Resource)
+		// nolint
+		object.Schema["data_object_type"].ValidateFunc = validation.StringInSlice([]string{"TABLE"}, false)
+		// nolint
+		object.Schema["history_data_sharing_status"].ValidateFunc = validation.StringInSlice([]string{
+			"ENABLED", "DISABLED"}, false)
+		object.Schema["start_version"].ValidateFunc = validation.IntAtLeast(0)
+		partition := object.Schema["partition"].Elem.(*schema.Resource)
+		value := partition.Schema["value"].Elem.(*schema.Resource)
+		// nolint
+		value.Schema["op"].ValidateFunc = validation.StringInSlice([]string{"EQUAL", "LIKE"}, false)
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var si ShareInfo
+			if err := common.DataToStructPointer(d, s, &si); err != nil {
+				return err
+			}
+			sharesAPI := NewSharesAPI(ctx, c)
+			if err := sharesAPI.Create(si.Name, si.Comment); err != nil {
+				return err
+			}
+			d.SetId(si.Name)
+			if len(si.Objects) == 0 {
+				return nil
+			}
+			return sharesAPI.Update(si.Name, "", sharedObjectDiff(nil, si.Objects))
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			si, err := NewSharesAPI(ctx, c).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			var configured ShareInfo
+			if err = common.DataToStructPointer(d, s, &configured); err != nil {
+				return err
+			}
+			si.Objects = sortObjectsLike(si.Objects, configured.Objects)
+			return common.StructToData(si, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var si ShareInfo
+			if err := common.DataToStructPointer(d, s, &si); err != nil {
+				return err
+			}
+			sharesAPI := NewSharesAPI(ctx, c)
+			// objects are compared with the actual share, so that changes made outside are reconciled
+			current, err := sharesAPI.Get(si.Name)
+			if err != nil {
+				return err
+			}
+			return sharesAPI.Update(si.Name, si.Comment, sharedObjectDiff(current.Objects, si.Objects))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewSharesAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,227 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceShareCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceShare())
+}
+
+func TestResourceShareCreate(t *testing.T) {
+	events := SharedDataObject{
+		Name:                     "main.sales.events",
+		DataObjectType:           "TABLE",
+		CDFEnabled:               true,
+		StartVersion:             10,
+		HistoryDataSharingStatus: "ENABLED",
+		Partitions: []Partition{
+			{
+				Values: []PartitionValue{
+					{
+						Name:  "region",
+						Op:    "EQUAL",
+						Value: "emea",
+					},
+				},
+			},
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/unity-catalog/shares",
+				ExpectedRequest: map[string]string{
+					"name":    "sales",
+					"comment": "for partners",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/unity-catalog/shares/sales",
+				ExpectedRequest: shareUpdate{
+					Updates: []SharedDataObjectUpdate{
+						{"ADD", events},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/shares/sales?include_shared_data=true",
+				Response: ShareInfo{
+					Name:    "sales",
+					Comment: "for partners",
+					Owner:   "admins",
+					Objects: []SharedDataObject{
+						{
+							Name:                     "main.sales.events",
+							DataObjectType:           "TABLE",
+							SharedAs:                 "sales.events",
+							CDFEnabled:               true,
+							StartVersion:             10,
+							HistoryDataSharingStatus: "ENABLED",
+							Partitions:               events.Partitions,
+							Status:                   "ACTIVE",
+							AddedBy:                  "me@example.com",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceShare(),
+		Create:   true,
+		HCL: `
+		name = "sales"
+		comment = "for partners"
+		object {
+			name = "main.sales.events"
+			data_object_type = "TABLE"
+			cdf_enabled = true
+			start_version = 10
+			history_data_sharing_status = "ENABLED"
+			partition {
+				value {
+					name = "region"
+					op = "EQUAL"
+					value = "emea"
+				}
+			}
+		}`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "sales", d.Id())
+	assert.Equal(t, "sales.events", d.Get("object.0.shared_as"))
+	assert.Equal(t, "emea", d.Get("object.0.partition.0.value.0.value"))
+}
+
+func TestResourceShareCreate_InvalidHistorySharing(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceShare(),
+		Create:   true,
+		HCL: `
+		name = "sales"
+		object {
+			name = "main.sales.events"
+			data_object_type = "TABLE"
+			history_data_sharing_status = "YES"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [object.#.history_data_sharing_status] "+
+		"expected object.0.history_data_sharing_status to be one of [ENABLED DISABLED], got YES")
+}
+
+func TestSharedObjectDiff(t *testing.T) {
+	current := []SharedDataObject{
+		{
+			Name:           "a",
+			DataObjectType: "TABLE",
+			SharedAs:       "s.a",
+			Status:         "ACTIVE",
+		},
+		{
+			Name:           "b",
+			DataObjectType: "TABLE",
+			Status:         "ACTIVE",
+		},
+		{
+			Name:           "c",
+			DataObjectType: "TABLE",
+			CDFEnabled:     true,
+		},
+	}
+	configured := []SharedDataObject{
+		{
+			Name:           "a",
+			DataObjectType: "TABLE",
+		},
+		{
+			Name:           "c",
+			DataObjectType: "TABLE",
+		},
+		{
+			Name:           "d",
+			DataObjectType: "TABLE",
+		},
+	}
+	assert.Equal(t, []SharedDataObjectUpdate{
+		{"UPDATE", configured[1]},
+		{"ADD", configured[2]},
+		{"REMOVE", SharedDataObject{Name: "b", DataObjectType: "TABLE"}},
+	}, sharedObjectDiff(current, configured))
+}
+
+func TestSortObjectsLike(t *testing.T) {
+	sorted := sortObjectsLike([]SharedDataObject{
+		{Name: "a"}, {Name: "b"}, {Name: "c"},
+	}, []SharedDataObject{
+		{Name: "c"}, {Name: "x"}, {Name: "a"},
+	})
+	assert.Equal(t, []SharedDataObject{
+		{Name: "c"}, {Name: "a"}, {Name: "b"},
+	}, sorted)
+}
+
+func TestResourceShareUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/shares/sales?include_shared_data=true",
+				Response: ShareInfo{
+					Name: "sales",
+					Objects: []SharedDataObject{
+						{
+							Name:           "main.sales.events",
+							DataObjectType: "TABLE",
+							SharedAs:       "sales.events",
+						},
+					},
+				},
+				ReuseRequest: true,
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/unity-catalog/shares/sales",
+				ExpectedRequest: shareUpdate{
+					Comment: "changed",
+					Updates: []SharedDataObjectUpdate{
+						{"REMOVE", SharedDataObject{
+							Name:           "main.sales.events",
+							DataObjectType: "TABLE",
+						}},
+					},
+				},
+			},
+		},
+		Resource: ResourceShare(),
+		Update:   true,
+		ID:       "sales",
+		InstanceState: map[string]string{
+			"name":                      "sales",
+			"object.#":                  "1",
+			"object.0.name":             "main.sales.events",
+			"object.0.data_object_type": "TABLE",
+		},
+		HCL: `
+		name = "sales"
+		comment = "changed"`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceShareDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/unity-catalog/shares/sales",
+			},
+		},
+		Resource: ResourceShare(),
+		Delete:   true,
+		ID:       "sales",
+	}.ApplyNoError(t)
+}
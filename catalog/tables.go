@@ -0,0 +1,51 @@
+package catalog
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+)
+
+// NewTablesAPI creates TablesAPI instance from provider meta
+func NewTablesAPI(ctx context.Context, m interface{}) TablesAPI {
+	return TablesAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// TablesAPI exposes the Unity Catalog Tables API
+type TablesAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// ColumnInfo describes a single column of Unity Catalog table
+type ColumnInfo struct {
+	Name     string `json:"name"`
+	Position int    `json:"position,omitempty" tf:"computed"`
+	TypeName string `json:"type_name,omitempty" tf:"computed"`
+	TypeText string `json:"type_text,omitempty" tf:"computed"`
+	Nullable bool   `json:"nullable,omitempty" tf:"computed"`
+	Comment  string `json:"comment,omitempty" tf:"computed"`
+}
+
+// TableInfo contains information about Unity Catalog table or view
+type TableInfo struct {
+	FullName         string            `json:"full_name" tf:"alias:name"`
+	Name             string            `json:"name,omitempty" tf:"alias:table_name,computed"`
+	CatalogName      string            `json:"catalog_name,omitempty" tf:"computed"`
+	SchemaName       string            `json:"schema_name,omitempty" tf:"computed"`
+	TableType        string            `json:"table_type,omitempty" tf:"computed"`
+	DataSourceFormat string            `json:"data_source_format,omitempty" tf:"computed"`
+	Columns          []ColumnInfo      `json:"columns,omitempty" tf:"computed"`
+	StorageLocation  string            `json:"storage_location,omitempty" tf:"computed"`
+	ViewDefinition   string            `json:"view_definition,omitempty" tf:"computed"`
+	Owner            string            `json:"owner,omitempty" tf:"computed"`
+	Comment          string            `json:"comment,omitempty" tf:"computed"`
+	Properties       map[string]string `json:"properties,omitempty" tf:"computed"`
+	TableID          string            `json:"table_id,omitempty" tf:"computed"`
+}
+
+// Get returns table by its full name, e.g. `main.default.events`
+func (a TablesAPI) Get(fullName string) (ti TableInfo, err error) {
+	err = a.client.Get(a.context, "/unity-catalog/tables/"+fullName, nil, &ti)
+	return
+}
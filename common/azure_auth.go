@@ -67,7 +67,11 @@ type tokenInfo struct {
 	Comment      string `json:"comment,omitempty"`
 }
 
-var authorizerMutex sync.Mutex
+var (
+	authorizerMutex sync.Mutex
+	// resource id and its parts are lazily filled from each other
+	resourceIDMutex sync.Mutex
+)
 
 func (aa *AzureAuth) getAzureEnvironment() (azure.Environment, error) {
 	// Used for unit testing purposes
@@ -86,6 +90,8 @@ func (aa *AzureAuth) getAzureEnvironment() (azure.Environment, error) {
 }
 
 func (aa *AzureAuth) resourceID() string {
+	resourceIDMutex.Lock()
+	defer resourceIDMutex.Unlock()
 	if aa.ResourceID != "" {
 		if aa.SubscriptionID == "" {
 			res, err := azure.ParseResourceID(aa.ResourceID)
@@ -188,22 +194,19 @@ func (aa *AzureAuth) simpleAADRequestVisitor(
 	if err != nil {
 		return nil, err
 	}
+	resourceID := aa.resourceID()
+	// visitor is called concurrently, so it must not share variables with this function
 	return func(r *http.Request) error {
 		if len(visitors) > 0 {
-			err = visitors[0](r, managementAuthorizer)
-			if err != nil {
+			if err := visitors[0](r, managementAuthorizer); err != nil {
 				return err
 			}
 		}
-		resourceID := aa.resourceID()
 		if resourceID != "" {
 			r.Header.Set("X-Databricks-Azure-Workspace-Resource-Id", resourceID)
 		}
-		_, err = autorest.Prepare(r, platformAuthorizer.WithAuthorization())
-		if err != nil {
-			return err
-		}
-		return nil
+		_, err := autorest.Prepare(r, platformAuthorizer.WithAuthorization())
+		return err
 	}, nil
 }
 
@@ -211,13 +214,10 @@ func (aa *AzureAuth) acquirePAT(
 	ctx context.Context,
 	factory func(resource string) (autorest.Authorizer, error),
 	visitors ...func(r *http.Request, ma autorest.Authorizer) error) (*tokenResponse, error) {
-	if aa.temporaryPat != nil {
-		// todo: add IsExpired
-		return aa.temporaryPat, nil
-	}
 	authorizerMutex.Lock()
 	defer authorizerMutex.Unlock()
 	if aa.temporaryPat != nil {
+		// todo: add IsExpired
 		return aa.temporaryPat, nil
 	}
 	env, err := aa.getAzureEnvironment()
@@ -7,7 +7,6 @@ import (
 	"net/http/httptest"
 	"os"
 	"strings"
-	"sync"
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest"
@@ -53,7 +52,6 @@ func TestAddSpManagementTokenVisitor_Refreshed(t *testing.T) {
 	aa := AzureAuth{}
 	r := httptest.NewRequest("GET", "/a/b/c", http.NoBody)
 	rct := refreshableCliToken{
-		lock:           &sync.RWMutex{},
 		resource:       "x",
 		refreshMinutes: 6,
 	}
@@ -70,7 +68,6 @@ func TestAddSpManagementTokenVisitor_RefreshedError(t *testing.T) {
 	aa := AzureAuth{}
 	r := httptest.NewRequest("GET", "/a/b/c", http.NoBody)
 	rct := refreshableCliToken{
-		lock:           &sync.RWMutex{},
 		resource:       "x",
 		refreshMinutes: 6,
 	}
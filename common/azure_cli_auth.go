@@ -18,12 +18,14 @@ import (
 type refreshableCliToken struct {
 	resource       string
 	token          *adal.Token
-	lock           *sync.RWMutex
+	lock           sync.RWMutex
 	refreshMinutes int
 }
 
 // OAuthToken implements adal.OAuthTokenProvider
 func (rct *refreshableCliToken) OAuthToken() string {
+	rct.lock.RLock()
+	defer rct.lock.RUnlock()
 	if rct.token == nil {
 		return ""
 	}
@@ -33,7 +35,10 @@ func (rct *refreshableCliToken) OAuthToken() string {
 // EnsureFreshWithContext implements adal.RefresherWithContext
 func (rct *refreshableCliToken) EnsureFreshWithContext(ctx context.Context) error {
 	refreshInterval := time.Duration(rct.refreshMinutes) * time.Minute
-	if rct.token != nil && !rct.token.WillExpireIn(refreshInterval) {
+	rct.lock.RLock()
+	fresh := rct.token != nil && !rct.token.WillExpireIn(refreshInterval)
+	rct.lock.RUnlock()
+	if fresh {
 		return nil
 	}
 	rct.lock.Lock()
@@ -84,7 +89,6 @@ func (rct *refreshableCliToken) refreshInternal(resource string) (err error) {
 
 func (aa *AzureAuth) cliAuthorizer(resource string) (autorest.Authorizer, error) {
 	rct := refreshableCliToken{
-		lock:           &sync.RWMutex{},
 		resource:       resource,
 		refreshMinutes: 6,
 	}
@@ -7,7 +7,6 @@ import (
 	"net/http/httptest"
 	"os"
 	"strings"
-	"sync"
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/adal"
@@ -75,7 +74,6 @@ func TestEnsureFreshWithContext(t *testing.T) {
 		token: &adal.Token{
 			ExpiresIn: "10",
 		},
-		lock: &sync.RWMutex{},
 	}
 	err := rct.EnsureFreshWithContext(context.Background())
 	assert.NoError(t, err)
@@ -89,7 +87,6 @@ func TestRefreshWithContext(t *testing.T) {
 		token: &adal.Token{
 			ExpiresIn: "10",
 		},
-		lock: &sync.RWMutex{},
 	}
 	err := rct.RefreshWithContext(context.Background())
 	assert.NoError(t, err)
@@ -103,7 +100,6 @@ func TestRefreshExchangeWithContext(t *testing.T) {
 		token: &adal.Token{
 			ExpiresIn: "10",
 		},
-		lock: &sync.RWMutex{},
 	}
 	err := rct.RefreshExchangeWithContext(context.Background(), "a")
 	assert.NoError(t, err)
@@ -118,7 +114,6 @@ func TestInternalRefresh_ExitError(t *testing.T) {
 		token: &adal.Token{
 			ExpiresIn: "10",
 		},
-		lock: &sync.RWMutex{},
 	}
 	err := rct.refreshInternal("a")
 	assert.EqualError(t, err, "cannot get access token: This is just a failing script.\n")
@@ -132,7 +127,6 @@ func TestInternalRefresh_OtherError(t *testing.T) {
 		token: &adal.Token{
 			ExpiresIn: "10",
 		},
-		lock: &sync.RWMutex{},
 	}
 	err := rct.refreshInternal("a")
 	assert.EqualError(t, err, "cannot get access token: exec: \"az\": executable file not found in $PATH")
@@ -147,7 +141,6 @@ func TestInternalRefresh_Corrupt(t *testing.T) {
 		token: &adal.Token{
 			ExpiresIn: "10",
 		},
-		lock: &sync.RWMutex{},
 	}
 	err := rct.refreshInternal("a")
 	assert.EqualError(t, err, "invalid character 'a' looking for beginning of object key string")
@@ -162,7 +155,6 @@ func TestInternalRefresh_CorruptExpire(t *testing.T) {
 		token: &adal.Token{
 			ExpiresIn: "10",
 		},
-		lock: &sync.RWMutex{},
 	}
 	err := rct.refreshInternal("a")
 	require.Error(t, err)
@@ -36,6 +36,9 @@ type DatabricksClient struct {
 	Profile            string
 	ConfigFile         string
 	AccountID          string
+	OIDCToken          string
+	AuthType           string
+	ClientID           string
 	AzureAuth          AzureAuth
 	InsecureSkipVerify bool
 	HTTPTimeoutSeconds int
@@ -48,6 +51,8 @@ type DatabricksClient struct {
 	httpClient         *retryablehttp.Client
 	authVisitor        func(r *http.Request) error
 	commandFactory     func(context.Context, *DatabricksClient) CommandExecutor
+	cacheMutex         sync.Mutex
+	cachedResponses    map[string][]byte
 }
 
 // Configure client to work
@@ -60,11 +65,10 @@ func (c *DatabricksClient) Configure() error {
 	return nil
 }
 
-// Authenticate authenticates across providers or returns error
+// Authenticate authenticates across providers or returns error. It's called before
+// every request, so the lock is always taken to make auth fields and host visible
+// to all concurrent requests.
 func (c *DatabricksClient) Authenticate() error {
-	if c.authVisitor != nil {
-		return nil
-	}
 	c.authMutex.Lock()
 	defer c.authMutex.Unlock()
 	if c.authVisitor != nil {
@@ -74,6 +78,7 @@ func (c *DatabricksClient) Authenticate() error {
 		c.configureAuthWithDirectParams,
 		c.AzureAuth.configureWithClientSecret,
 		c.AzureAuth.configureWithAzureCLI,
+		c.configureWithOIDCTokenFederation,
 		c.configureFromDatabricksCfg,
 	}
 	for _, authProvider := range authorizers {
@@ -95,7 +100,9 @@ func (c *DatabricksClient) Authenticate() error {
 		"3. azure_databricks_workspace_id + AZ CLI authentication.\n" +
 		"4. azure_databricks_workspace_id + azure_client_id + azure_client_secret + azure_tenant_id " +
 		"for Azure Service Principal authentication.\n" +
-		"5. Run `databricks configure --token` that will create ~/.databrickscfg file.\n\n" +
+		"5. host + oidc_token or auth_type = \"github-oidc\" in GitHub Actions workflow with " +
+		"`id-token: write` permission for OIDC token federation.\n" +
+		"6. Run `databricks configure --token` that will create ~/.databrickscfg file.\n\n" +
 		"Please check https://registry.terraform.io/providers/databrickslabs/databricks/latest/docs#authentication for details")
 }
 
@@ -191,6 +198,37 @@ func (c *DatabricksClient) encodeBasicAuth(username, password string) string {
 	return base64.StdEncoding.EncodeToString([]byte(tokenUnB64))
 }
 
+var (
+	transportsMutex sync.Mutex
+	transports      = map[string]*http.Transport{}
+)
+
+// sharedTransport returns the same transport for all clients of the host, so that
+// parallel resources reuse connections instead of opening new ones
+func sharedTransport(host string, insecureSkipVerify bool) *http.Transport {
+	transportsMutex.Lock()
+	defer transportsMutex.Unlock()
+	key := fmt.Sprintf("%s|%v", strings.TrimSuffix(host, "/"), insecureSkipVerify)
+	if t, ok := transports[key]; ok {
+		return t
+	}
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+	t := &http.Transport{
+		Proxy:                 defaultTransport.Proxy,
+		DialContext:           defaultTransport.DialContext,
+		MaxIdleConns:          defaultTransport.MaxIdleConns,
+		MaxIdleConnsPerHost:   DefaultRateLimitPerSecond,
+		IdleConnTimeout:       defaultTransport.IdleConnTimeout * 3,
+		TLSHandshakeTimeout:   defaultTransport.TLSHandshakeTimeout * 3,
+		ExpectContinueTimeout: defaultTransport.ExpectContinueTimeout,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: insecureSkipVerify,
+		},
+	}
+	transports[key] = t
+	return t
+}
+
 func (c *DatabricksClient) configureHTTPCLient() {
 	if c.HTTPTimeoutSeconds == 0 {
 		c.HTTPTimeoutSeconds = DefaultHTTPTimeoutSeconds
@@ -203,21 +241,10 @@ func (c *DatabricksClient) configureHTTPCLient() {
 	// a transient error on initial creation
 	retryDelayDuration := 10 * time.Second
 	retryMaximumDuration := 5 * time.Minute
-	defaultTransport := http.DefaultTransport.(*http.Transport)
 	c.httpClient = &retryablehttp.Client{
 		HTTPClient: &http.Client{
-			Timeout: time.Duration(c.HTTPTimeoutSeconds) * time.Second,
-			Transport: &http.Transport{
-				Proxy:                 defaultTransport.Proxy,
-				DialContext:           defaultTransport.DialContext,
-				MaxIdleConns:          defaultTransport.MaxIdleConns,
-				IdleConnTimeout:       defaultTransport.IdleConnTimeout * 3,
-				TLSHandshakeTimeout:   defaultTransport.TLSHandshakeTimeout * 3,
-				ExpectContinueTimeout: defaultTransport.ExpectContinueTimeout,
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: c.InsecureSkipVerify,
-				},
-			},
+			Timeout:   time.Duration(c.HTTPTimeoutSeconds) * time.Second,
+			Transport: retryingTransport{sharedTransport(c.Host, c.InsecureSkipVerify)},
 		},
 		CheckRetry: c.checkHTTPRetry,
 		// Using a linear retry rather than the default exponential retry
@@ -1,11 +1,16 @@
 package common
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func AssertErrorStartsWith(t *testing.T, err error, message string) bool {
@@ -156,3 +161,49 @@ func TestDatabricksClient_FormatURL(t *testing.T) {
 	client := DatabricksClient{Host: "https://some.host"}
 	assert.Equal(t, "https://some.host/#job/123", client.FormatURL("#job/123"))
 }
+
+func TestDatabricksClient_ConcurrentRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Bearer ..", req.Header.Get("Authorization"))
+			_, err := rw.Write([]byte(`{"a": "b"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	client := DatabricksClient{
+		Host:               server.URL,
+		Token:              "..",
+		RateLimitPerSecond: 1000,
+	}
+	require.NoError(t, client.Configure())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resp map[string]string
+			err := client.Get(context.Background(), "/imaginary/endpoint", nil, &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, "b", resp["a"])
+			assert.False(t, client.IsAzure())
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDatabricksClient_SharesTransportPerHost(t *testing.T) {
+	a := DatabricksClient{Host: "https://a.cloud.databricks.com"}
+	b := DatabricksClient{Host: "https://a.cloud.databricks.com"}
+	c := DatabricksClient{Host: "https://c.cloud.databricks.com"}
+	d := DatabricksClient{Host: "https://a.cloud.databricks.com", InsecureSkipVerify: true}
+	for _, x := range []*DatabricksClient{&a, &b, &c, &d} {
+		require.NoError(t, x.Configure())
+	}
+	transport := func(x *DatabricksClient) http.RoundTripper {
+		return x.httpClient.HTTPClient.Transport
+	}
+	assert.True(t, transport(&a) == transport(&b))
+	assert.False(t, transport(&a) == transport(&c))
+	assert.False(t, transport(&a) == transport(&d))
+}
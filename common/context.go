@@ -3,6 +3,7 @@ package common
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,3 +44,12 @@ func addContextToStage(name string,
 		return f(ctx, d, m)
 	}
 }
+
+// TimeoutFromContext returns the time left until the deadline of context, that is set by
+// `timeouts {}` block of the resource, or fallback, if context has no deadline
+func TimeoutFromContext(ctx context.Context, fallback time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	return fallback
+}
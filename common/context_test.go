@@ -3,6 +3,7 @@ package common
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,3 +33,13 @@ func TestAddContextToAllResources(t *testing.T) {
 	AddContextToAllResources(p, "foo")
 	p.ResourcesMap["foo_bar"].CreateContext(context.Background(), nil, nil)
 }
+
+func TestTimeoutFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, 5*time.Minute, TimeoutFromContext(ctx, 5*time.Minute))
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	timeout := TimeoutFromContext(ctx, 5*time.Minute)
+	assert.True(t, timeout <= 2*time.Minute && timeout > time.Minute, "%s", timeout)
+}
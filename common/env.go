@@ -33,6 +33,8 @@ func NewClientFromEnvironment() *DatabricksClient {
 		Password:   os.Getenv("DATABRICKS_PASSWORD"),
 		ConfigFile: os.Getenv("DATABRICKS_CONFIG_FILE"),
 		Profile:    os.Getenv("DATABRICKS_CONFIG_PROFILE"),
+		OIDCToken:  os.Getenv("DATABRICKS_OIDC_TOKEN"),
+		ClientID:   os.Getenv("DATABRICKS_CLIENT_ID"),
 		AzureAuth: AzureAuth{
 			ResourceID:     os.Getenv("DATABRICKS_AZURE_WORKSPACE_RESOURCE_ID"),
 			WorkspaceName:  os.Getenv("DATABRICKS_AZURE_WORKSPACE_NAME"),
@@ -12,10 +12,12 @@ import (
 	"net/url"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/google/go-querystring/query"
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 var (
@@ -49,6 +51,8 @@ type APIError struct {
 	Message    string
 	Resource   string
 	StatusCode int
+	Method     string
+	RequestID  string
 }
 
 // Error returns error message string instead of
@@ -60,6 +64,43 @@ func (apiError APIError) Error() string {
 	return apiError.Message
 }
 
+// Details describes the failed request, so that it could be given to support
+func (apiError APIError) Details() string {
+	details := []string{}
+	if apiError.Method != "" || apiError.Resource != "" {
+		details = append(details, strings.TrimSpace(fmt.Sprintf("%s %s", apiError.Method, apiError.Resource)))
+	}
+	if apiError.StatusCode != 0 {
+		details = append(details, fmt.Sprintf("status %d", apiError.StatusCode))
+	}
+	if apiError.ErrorCode != "" {
+		details = append(details, fmt.Sprintf("error code %s", apiError.ErrorCode))
+	}
+	if apiError.RequestID != "" {
+		details = append(details, fmt.Sprintf("request id %s", apiError.RequestID))
+	}
+	return strings.Join(details, ", ")
+}
+
+// DiagnosticsFromErr converts error to diagnostics, adding details of the failed API request,
+// like HTTP method, path, status, error code and request id, if there are any
+func DiagnosticsFromErr(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	var apiError APIError
+	if !errors.As(err, &apiError) {
+		return diag.FromErr(err)
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   apiError.Details(),
+		},
+	}
+}
+
 // IsMissing tells if it is missing resource
 func (apiError APIError) IsMissing() bool {
 	return apiError.StatusCode == http.StatusNotFound
@@ -128,7 +169,18 @@ func (c *DatabricksClient) parseUnknownError(
 	return
 }
 
+// requestOf returns method and path of originating request, if it's known
+func requestOf(resp *http.Response) (method, path string) {
+	if resp.Request == nil || resp.Request.URL == nil {
+		return "", ""
+	}
+	return resp.Request.Method, resp.Request.URL.Path
+}
+
 func (c *DatabricksClient) commonErrorClarity(resp *http.Response) *APIError {
+	if resp.Request == nil || resp.Request.URL == nil {
+		return nil
+	}
 	isAccountsAPI := strings.HasPrefix(resp.Request.URL.Path, "/api/2.0/accounts")
 	isAccountsClient := strings.Contains(c.Host, accountsHost)
 	isTesting := strings.HasPrefix(resp.Request.URL.Host, "127.0.0.1")
@@ -161,13 +213,21 @@ func (c *DatabricksClient) commonErrorClarity(resp *http.Response) *APIError {
 }
 
 func (c *DatabricksClient) parseError(resp *http.Response) APIError {
+	apiError := c.parseErrorBody(resp)
+	apiError.Method, _ = requestOf(resp)
+	apiError.RequestID = resp.Header.Get("x-request-id")
+	return apiError
+}
+
+func (c *DatabricksClient) parseErrorBody(resp *http.Response) APIError {
+	_, path := requestOf(resp)
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return APIError{
 			Message:    err.Error(),
 			ErrorCode:  "IO_READ",
 			StatusCode: resp.StatusCode,
-			Resource:   resp.Request.URL.Path,
+			Resource:   path,
 		}
 	}
 	log.Printf("[DEBUG] %s %v", resp.Status, c.redactedDump(body))
@@ -198,7 +258,7 @@ func (c *DatabricksClient) parseError(resp *http.Response) APIError {
 		Message:    errorBody.Message,
 		ErrorCode:  errorBody.ErrorCode,
 		StatusCode: resp.StatusCode,
-		Resource:   resp.Request.URL.Path,
+		Resource:   path,
 	}
 }
 
@@ -214,10 +274,14 @@ func (c *DatabricksClient) checkHTTPRetry(ctx context.Context, resp *http.Respon
 		return false, err
 	}
 	if resp.StatusCode == 429 {
+		method, path := requestOf(resp)
 		return true, APIError{
 			ErrorCode:  "TOO_MANY_REQUESTS",
 			Message:    "Current request has to be retried",
 			StatusCode: 429,
+			Resource:   path,
+			Method:     method,
+			RequestID:  resp.Header.Get("x-request-id"),
 		}
 	}
 	if resp.StatusCode >= 400 {
@@ -236,6 +300,27 @@ func (c *DatabricksClient) Get(ctx context.Context, path string, request interfa
 	return c.unmarshall(path, body, &response)
 }
 
+// GetCached on path remembers successful response body for the lifetime of the client,
+// so that rarely changing lists, like node types or spark versions, are fetched once
+// per plan, even if many data sources in different modules ask for them
+func (c *DatabricksClient) GetCached(ctx context.Context, path string, response interface{}) error {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+	body, ok := c.cachedResponses[path]
+	if !ok {
+		var err error
+		body, err = c.authenticatedQuery(ctx, http.MethodGet, path, nil, c.api2)
+		if err != nil {
+			return err
+		}
+		if c.cachedResponses == nil {
+			c.cachedResponses = map[string][]byte{}
+		}
+		c.cachedResponses[path] = body
+	}
+	return c.unmarshall(path, body, &response)
+}
+
 // Post on path
 func (c *DatabricksClient) Post(ctx context.Context, path string, request interface{}, response interface{}) error {
 	body, err := c.authenticatedQuery(ctx, http.MethodPost, path, request, c.api2)
@@ -329,6 +414,14 @@ func (c *DatabricksClient) Scim(ctx context.Context, method, path string, reques
 	return c.unmarshall(path, body, &response)
 }
 
+// Raw performs call on path with raw bytes as request and response bodies
+func (c *DatabricksClient) Raw(ctx context.Context, method, path string, request []byte) ([]byte, error) {
+	return c.authenticatedQuery(ctx, method, path, request, c.api2, func(r *http.Request) error {
+		r.Header.Set("Content-Type", "application/octet-stream")
+		return nil
+	})
+}
+
 // OldAPI performs call on context api
 func (c *DatabricksClient) OldAPI(ctx context.Context, method, path string, request interface{}, response interface{}) error {
 	body, err := c.authenticatedQuery(ctx, method, path, request, c.api12)
@@ -491,6 +584,8 @@ func makeRequestBody(method string, requestURL *string, data interface{}, marsha
 					strings.Replace(url.QueryEscape(fmt.Sprintf("%v", k.Interface())), "+", "%20", -1),
 					strings.Replace(url.QueryEscape(fmt.Sprintf("%v", v.Interface())), "+", "%20", -1)))
 			}
+			// map iteration order is random, but requests have to be reproducible
+			sort.Strings(s)
 			*requestURL += "?" + strings.Join(s, "&")
 		case reflect.Struct:
 			params, err := query.Values(data)
@@ -502,6 +597,10 @@ func makeRequestBody(method string, requestURL *string, data interface{}, marsha
 			return requestBody, fmt.Errorf("unsupported request data: %#v", data)
 		}
 	} else {
+		if raw, ok := data.([]byte); ok {
+			// raw payloads, like file contents, are sent as-is
+			return raw, nil
+		}
 		if marshalJSON {
 			bodyBytes, err := json.MarshalIndent(data, "", "  ")
 			if err != nil {
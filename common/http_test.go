@@ -167,6 +167,50 @@ func TestParseError_SCIMNull(t *testing.T) {
 		"Actual message: %s", err.Error())
 }
 
+func TestParseError_RequestDetails(t *testing.T) {
+	ws := DatabricksClient{
+		Host: "qwerty.cloud.databricks.com",
+	}
+	err := ws.parseError(&http.Response{
+		Request: httptest.NewRequest(
+			"POST", "https://querty.cloud.databricks.com/api/2.0/clusters/create",
+			nil),
+		StatusCode: 400,
+		Header: http.Header{
+			"X-Request-Id": []string{"f1d4c3b2-a1"},
+		},
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(`{
+			"error_code": "INVALID_PARAMETER_VALUE",
+			"message": "Missing required field: spark_version"
+		}`))),
+	})
+	assert.Equal(t, "Missing required field: spark_version", err.Error())
+	assert.Equal(t, "POST /api/2.0/clusters/create, status 400, "+
+		"error code INVALID_PARAMETER_VALUE, request id f1d4c3b2-a1", err.Details())
+}
+
+func TestDiagnosticsFromErr(t *testing.T) {
+	assert.Nil(t, DiagnosticsFromErr(nil))
+
+	diags := DiagnosticsFromErr(fmt.Errorf("plain"))
+	require.Len(t, diags, 1)
+	assert.Equal(t, "plain", diags[0].Summary)
+	assert.Equal(t, "", diags[0].Detail)
+
+	diags = DiagnosticsFromErr(fmt.Errorf("cannot create cluster: %w", APIError{
+		ErrorCode:  "INVALID_STATE",
+		Message:    "Cluster is terminated",
+		Resource:   "/api/2.0/clusters/start",
+		StatusCode: 400,
+		Method:     "POST",
+		RequestID:  "abc",
+	}))
+	require.Len(t, diags, 1)
+	assert.Equal(t, "cannot create cluster: Cluster is terminated", diags[0].Summary)
+	assert.Equal(t, "POST /api/2.0/clusters/start, status 400, "+
+		"error code INVALID_STATE, request id abc", diags[0].Detail)
+}
+
 func TestCheckHTTPRetry_Connection(t *testing.T) {
 	ws := DatabricksClient{
 		Host: "qwerty.cloud.databricks.com",
@@ -233,6 +277,51 @@ func TestGet_Error(t *testing.T) {
 		"Actual message: %s", err.Error())
 }
 
+func TestGetCached(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			calls++
+			assert.Equal(t, "/api/2.0/imaginary/endpoint", req.RequestURI)
+			_, err := rw.Write([]byte(`{"a": "b"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	ws := DatabricksClient{
+		Host:  server.URL + "/",
+		Token: "..",
+	}
+	require.NoError(t, ws.Configure())
+
+	for i := 0; i < 3; i++ {
+		var resp map[string]string
+		err := ws.GetCached(context.Background(), "/imaginary/endpoint", &resp)
+		require.NoError(t, err)
+		assert.Equal(t, "b", resp["a"])
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestGetCached_ErrorIsNotCached(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(400)
+			_, err := rw.Write([]byte(`{"error_code": "INVALID_REQUEST", "message": "Nope"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	ws := DatabricksClient{
+		Host:  server.URL + "/",
+		Token: "..",
+	}
+	require.NoError(t, ws.Configure())
+
+	var resp map[string]string
+	err := ws.GetCached(context.Background(), "/imaginary/endpoint", &resp)
+	require.Error(t, err)
+	assert.Len(t, ws.cachedResponses, 0)
+}
+
 func TestPost_Error(t *testing.T) {
 	ws, server := singleRequestServer(t, "POST", "/api/2.0/imaginary/endpoint", `{corrupt: "json"`)
 	defer server.Close()
@@ -332,6 +421,18 @@ func TestMakeRequestBody(t *testing.T) {
 
 	body, _ := makeRequestBody("POST", &requestURL, "abc", false)
 	assert.Equal(t, []byte("abc"), body)
+
+	body, _ = makeRequestBody("PUT", &requestURL, []byte("raw"), true)
+	assert.Equal(t, []byte("raw"), body)
+}
+
+func TestRaw(t *testing.T) {
+	ws, server := singleRequestServer(t, "PUT", "/api/2.0/imaginary/endpoint", `abc`)
+	defer server.Close()
+
+	body, err := ws.Raw(context.Background(), "PUT", "/imaginary/endpoint", []byte("xyz"))
+	require.NoError(t, err)
+	assert.Equal(t, "abc", string(body))
 }
 
 func TestClient_HandleErrors(t *testing.T) {
@@ -0,0 +1,203 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// AuthTypeGitHubOIDC enables exchange of GitHub Actions workflow ID token
+	AuthTypeGitHubOIDC = "github-oidc"
+
+	oidcTokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	oidcJWTTokenType           = "urn:ietf:params:oauth:token-type:jwt"
+
+	// federated tokens are exchanged again, when they are about to expire within this interval
+	oidcTokenRefreshInterval = 2 * time.Minute
+)
+
+// oidcTokenSource returns JWT of the workload, that is exchanged for Databricks token
+type oidcTokenSource func(ctx context.Context, audience string) (string, error)
+
+type federatedToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+
+	expiry time.Time
+}
+
+func (t *federatedToken) willExpireIn(d time.Duration) bool {
+	return !t.expiry.IsZero() && time.Now().Add(d).After(t.expiry)
+}
+
+type oidcErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type githubIDTokenResponse struct {
+	Value string `json:"value"`
+}
+
+// configureWithOIDCTokenFederation exchanges JWT of the workload identity, like GitHub Actions
+// or any other OIDC-compliant CI system, for short-lived Databricks token through federation
+// policies, so that no Databricks credentials have to be stored in the pipeline.
+func (c *DatabricksClient) configureWithOIDCTokenFederation() (func(r *http.Request) error, error) {
+	if c.Token != "" || c.Username != "" {
+		return nil, nil
+	}
+	source, err := c.oidcTokenSource()
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, nil
+	}
+	if c.Host == "" {
+		return nil, fmt.Errorf("host is empty, but is required by OIDC token federation")
+	}
+	c.fixHost()
+	log.Printf("[INFO] Using OIDC token federation authentication")
+	var lock sync.Mutex
+	var token *federatedToken
+	return func(r *http.Request) error {
+		lock.Lock()
+		defer lock.Unlock()
+		if token == nil || token.willExpireIn(oidcTokenRefreshInterval) {
+			t, err := c.exchangeOIDCToken(r.Context(), source)
+			if err != nil {
+				return fmt.Errorf("cannot exchange OIDC token: %w", err)
+			}
+			token = t
+		}
+		r.Header.Set("Authorization", fmt.Sprintf("%s %s", token.TokenType, token.AccessToken))
+		return nil
+	}, nil
+}
+
+// oidcTokenSource returns explicitly supplied JWT or the one from GitHub Actions environment,
+// if it was opted in with auth_type. Runners may have GitHub variables set for other jobs,
+// so they never trigger federation on their own.
+func (c *DatabricksClient) oidcTokenSource() (oidcTokenSource, error) {
+	if c.OIDCToken != "" {
+		return func(ctx context.Context, audience string) (string, error) {
+			return c.OIDCToken, nil
+		}, nil
+	}
+	if c.AuthType != AuthTypeGitHubOIDC {
+		return nil, nil
+	}
+	requestURL := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if requestURL == "" || requestToken == "" {
+		return nil, fmt.Errorf("auth_type is %s, but ACTIONS_ID_TOKEN_REQUEST_URL and "+
+			"ACTIONS_ID_TOKEN_REQUEST_TOKEN are not set. Does the workflow have `id-token: write` permission?",
+			AuthTypeGitHubOIDC)
+	}
+	return func(ctx context.Context, audience string) (string, error) {
+		return c.githubIDToken(ctx, requestURL, requestToken, audience)
+	}, nil
+}
+
+// oidcTokenEndpoint returns URL of token exchange endpoint of workspace or account
+func (c *DatabricksClient) oidcTokenEndpoint() string {
+	host := strings.TrimSuffix(c.Host, "/")
+	if c.AccountID != "" && strings.Contains(host, "://accounts.") {
+		return fmt.Sprintf("%s/oidc/accounts/%s/v1/token", host, c.AccountID)
+	}
+	return host + "/oidc/v1/token"
+}
+
+// githubIDToken requests ID token of the running GitHub Actions workflow. It is available
+// only for workflows with `id-token: write` permission.
+func (c *DatabricksClient) githubIDToken(ctx context.Context,
+	requestURL, requestToken, audience string) (string, error) {
+	separator := "?"
+	if strings.Contains(requestURL, "?") {
+		separator = "&"
+	}
+	requestURL = fmt.Sprintf("%s%saudience=%s", requestURL, separator, url.QueryEscape(audience))
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Authorization", "Bearer "+requestToken)
+	var idToken githubIDTokenResponse
+	if err = c.doOIDCRequest(request, &idToken); err != nil {
+		return "", fmt.Errorf("cannot get GitHub Actions ID token: %w", err)
+	}
+	if idToken.Value == "" {
+		return "", fmt.Errorf("GitHub Actions returned empty ID token")
+	}
+	return idToken.Value, nil
+}
+
+func (c *DatabricksClient) exchangeOIDCToken(ctx context.Context, source oidcTokenSource) (*federatedToken, error) {
+	endpoint := c.oidcTokenEndpoint()
+	subjectToken, err := source(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", oidcTokenExchangeGrantType)
+	form.Set("subject_token", subjectToken)
+	form.Set("subject_token_type", oidcJWTTokenType)
+	form.Set("scope", "all-apis")
+	if c.ClientID != "" {
+		// service principal federation policy, otherwise account-wide policy is used
+		form.Set("client_id", c.ClientID)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var token federatedToken
+	if err = c.doOIDCRequest(request, &token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("%s returned no access token", endpoint)
+	}
+	if token.TokenType == "" {
+		token.TokenType = "Bearer"
+	}
+	if token.ExpiresIn > 0 {
+		token.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
+
+func (c *DatabricksClient) doOIDCRequest(request *http.Request, response interface{}) error {
+	if c.httpClient == nil {
+		return fmt.Errorf("DatabricksClient is not configured")
+	}
+	resp, err := c.httpClient.HTTPClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var oidcErr oidcErrorResponse
+		if json.Unmarshal(body, &oidcErr) == nil && oidcErr.Error != "" {
+			return fmt.Errorf("%s: %s", oidcErr.Error, oidcErr.ErrorDescription)
+		}
+		return fmt.Errorf("%s: %s", resp.Status, onlyNBytes(string(body), c.DebugTruncateBytes))
+	}
+	return json.Unmarshal(body, response)
+}
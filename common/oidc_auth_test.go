@@ -0,0 +1,166 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func oidcTestServer(t *testing.T, exchanges *int, expectedClientID string) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/github/token":
+				assert.Equal(t, "Bearer gh-request-token", req.Header.Get("Authorization"))
+				assert.Equal(t, server.URL+"/oidc/v1/token", req.URL.Query().Get("audience"))
+				_, err := rw.Write([]byte(`{"value": "github-jwt"}`))
+				assert.NoError(t, err)
+			case "/oidc/v1/token":
+				*exchanges++
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, oidcTokenExchangeGrantType, req.PostForm.Get("grant_type"))
+				assert.Equal(t, oidcJWTTokenType, req.PostForm.Get("subject_token_type"))
+				assert.Equal(t, "all-apis", req.PostForm.Get("scope"))
+				assert.Equal(t, expectedClientID, req.PostForm.Get("client_id"))
+				if req.PostForm.Get("subject_token") == "invalid" {
+					rw.WriteHeader(400)
+					_, err := rw.Write([]byte(`{"error": "invalid_request", ` +
+						`"error_description": "no matching federation policy"}`))
+					assert.NoError(t, err)
+					return
+				}
+				_, err := rw.Write([]byte(fmt.Sprintf(`{"access_token": "dbx-%s", `+
+					`"token_type": "Bearer", "expires_in": 3600}`, req.PostForm.Get("subject_token"))))
+				assert.NoError(t, err)
+			case "/api/2.0/clusters/list-zones":
+				_, err := rw.Write([]byte(fmt.Sprintf(`{"zones": [%q]}`, req.Header.Get("Authorization"))))
+				assert.NoError(t, err)
+			default:
+				assert.Fail(t, fmt.Sprintf("Received unexpected call: %s %s",
+					req.Method, req.RequestURI))
+			}
+		}))
+	return server
+}
+
+func listZonesAuthorization(t *testing.T, client *DatabricksClient) string {
+	var zi struct {
+		Zones []string `json:"zones"`
+	}
+	err := client.Get(context.Background(), "/clusters/list-zones", nil, &zi)
+	require.NoError(t, err)
+	require.Len(t, zi.Zones, 1)
+	return zi.Zones[0]
+}
+
+func TestOIDCTokenFederation_SuppliedToken(t *testing.T) {
+	defer CleanupEnvironment()()
+	exchanges := 0
+	server := oidcTestServer(t, &exchanges, "sp-application-id")
+	defer server.Close()
+
+	client := DatabricksClient{
+		Host:      server.URL,
+		OIDCToken: "supplied-jwt",
+		ClientID:  "sp-application-id",
+	}
+	require.NoError(t, client.Configure())
+
+	assert.Equal(t, "Bearer dbx-supplied-jwt", listZonesAuthorization(t, &client))
+	assert.Equal(t, "Bearer dbx-supplied-jwt", listZonesAuthorization(t, &client))
+	// token is exchanged once and reused until it is about to expire
+	assert.Equal(t, 1, exchanges)
+}
+
+func TestOIDCTokenFederation_GitHubActions(t *testing.T) {
+	defer CleanupEnvironment()()
+	exchanges := 0
+	server := oidcTestServer(t, &exchanges, "")
+	defer server.Close()
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", server.URL+"/github/token")
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "gh-request-token")
+
+	client := DatabricksClient{
+		Host:     server.URL,
+		AuthType: AuthTypeGitHubOIDC,
+	}
+	require.NoError(t, client.Configure())
+
+	assert.Equal(t, "Bearer dbx-github-jwt", listZonesAuthorization(t, &client))
+	assert.Equal(t, 1, exchanges)
+}
+
+func TestOIDCTokenFederation_GitHubActionsNeedsOptIn(t *testing.T) {
+	defer CleanupEnvironment()()
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", "https://github.localhost/token")
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "gh-request-token")
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		ConfigFile: "testdata/.databrickscfg",
+		Profile:    "DEFAULT",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "PT0+IC9kZXYvdXJhbmRvbSA8PT0KYFZ", dc.Token)
+}
+
+func TestOIDCTokenFederation_GitHubActionsNoIDToken(t *testing.T) {
+	defer CleanupEnvironment()()
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://localhost:443",
+		AuthType: AuthTypeGitHubOIDC,
+	})
+	AssertErrorStartsWith(t, err, "auth_type is github-oidc, but ACTIONS_ID_TOKEN_REQUEST_URL "+
+		"and ACTIONS_ID_TOKEN_REQUEST_TOKEN are not set")
+}
+
+func TestOIDCTokenFederation_ExchangeFailure(t *testing.T) {
+	defer CleanupEnvironment()()
+	exchanges := 0
+	server := oidcTestServer(t, &exchanges, "")
+	defer server.Close()
+
+	client := DatabricksClient{
+		Host:      server.URL,
+		OIDCToken: "invalid",
+	}
+	require.NoError(t, client.Configure())
+
+	err := client.Get(context.Background(), "/clusters/list-zones", nil, nil)
+	AssertErrorStartsWith(t, err, "cannot exchange OIDC token: invalid_request: no matching federation policy")
+}
+
+func TestOIDCTokenFederation_NoHost(t *testing.T) {
+	defer CleanupEnvironment()()
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		OIDCToken: "supplied-jwt",
+	})
+	AssertErrorStartsWith(t, err, "host is empty, but is required by OIDC token federation")
+}
+
+func TestOIDCTokenFederation_TokenTakesPrecedence(t *testing.T) {
+	defer CleanupEnvironment()()
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", "https://github.localhost/token")
+	os.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "gh-request-token")
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:  "https://localhost:443",
+		Token: "dapi",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "dapi", dc.Token)
+}
+
+func TestOIDCTokenEndpoint(t *testing.T) {
+	assert.Equal(t, "https://abc.cloud.databricks.com/oidc/v1/token", (&DatabricksClient{
+		Host: "https://abc.cloud.databricks.com/",
+	}).oidcTokenEndpoint())
+	assert.Equal(t, "https://accounts.cloud.databricks.com/oidc/accounts/xyz/v1/token", (&DatabricksClient{
+		Host:      "https://accounts.cloud.databricks.com",
+		AccountID: "xyz",
+	}).oidcTokenEndpoint())
+}
@@ -83,7 +83,7 @@ type BindResource struct {
 
 // BindResource creates resource that relies on binding ID pair with simple schema & importer
 func (p *Pair) BindResource(pr BindResource) *schema.Resource {
-	return Resource{
+	r := Resource{
 		Schema: p.schema,
 		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
 			left, right, err := p.Unpack(d)
@@ -116,4 +116,22 @@ func (p *Pair) BindResource(pr BindResource) *schema.Resource {
 			return pr.DeleteContext(ctx, left, right, c)
 		},
 	}.ToResource()
+	r.Importer = &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData,
+			m interface{}) ([]*schema.ResourceData, error) {
+			id := d.Id()
+			left, right, err := p.Unpack(d)
+			if err != nil {
+				return nil, fmt.Errorf("%w, expected format is %s%s%s",
+					err, p.left, p.separator, p.right)
+			}
+			// binding is adopted only if both halves exist and are bound together
+			err = pr.ReadContext(ctx, left, right, m.(*DatabricksClient))
+			if err != nil {
+				return nil, fmt.Errorf("cannot import %s: %w", id, err)
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+	return r
 }
@@ -17,6 +17,7 @@ import (
 func TestPairIDResource(t *testing.T) {
 	type bindResourceFixture struct {
 		create, read, delete bool
+		importing            bool
 		left, right          string
 		id                   string
 		assertID             string
@@ -128,6 +129,27 @@ func TestPairIDResource(t *testing.T) {
 			err:         fmt.Errorf("Nope"),
 			assertError: "Nope",
 		},
+		{
+			importing:   true,
+			id:          "a",
+			assertError: "invalid ID: a, expected format is left_id|right_id",
+		},
+		{
+			importing:   true,
+			id:          "a|b",
+			left:        "a",
+			right:       "b",
+			assertID:    "a|b",
+			err:         NotFound("Nope"),
+			assertError: "cannot import a|b: Nope",
+		},
+		{
+			importing: true,
+			id:        "a|b",
+			left:      "a",
+			right:     "b",
+			assertID:  "a|b",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("%#v", tt), func(t *testing.T) {
@@ -168,6 +190,8 @@ func TestPairIDResource(t *testing.T) {
 				diags = resource.ReadContext(ctx, d, client)
 			case tt.delete:
 				diags = resource.DeleteContext(ctx, d, client)
+			case tt.importing:
+				_, err = resource.Importer.StateContext(ctx, d, client)
 			}
 			if diags != nil {
 				err = errors.New(diags[0].Summary)
@@ -0,0 +1,211 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const sensitiveFieldNames = `(token_value|token|client_secret|password|` +
+	`personal_access_token|string_value|access_key|secret_key|private_key|` +
+	`[a-z_]*api_key|aws_secret_access_key)`
+
+// sensitiveJSONFields are masked in recorded request and response bodies
+var sensitiveJSONFields = regexp.MustCompile(`"` + sensitiveFieldNames + `"\s*:\s*"[^"]*"`)
+
+// sensitiveHCLFields are masked in rendered configurations of test steps
+var sensitiveHCLFields = regexp.MustCompile(`\b` + sensitiveFieldNames + `(\s*=\s*)"[^"]*"`)
+
+// sensitiveEnvNames are environment variables, which values are masked everywhere in
+// cassette, as they might get into configurations through environment templates
+var sensitiveEnvNames = regexp.MustCompile(`(?i)(TOKEN|SECRET|PASSWORD|KEY)`)
+
+// minSecretLength prevents masking of short values, like flags, in cassettes
+const minSecretLength = 8
+
+// Interaction is a single sanitized API call
+type Interaction struct {
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	Request  string `json:"request,omitempty"`
+	Status   int    `json:"status"`
+	Response string `json:"response,omitempty"`
+}
+
+// Cassette holds API interactions of a test together with rendered configurations
+// of its steps, so that the same requests are sent during replay
+type Cassette struct {
+	Host         string        `json:"host"`
+	Configs      []string      `json:"configs,omitempty"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is HTTP transport, that either records real API interactions into cassette
+// or replays them without reaching the network
+type Recorder struct {
+	Cassette Cassette
+
+	mu      sync.Mutex
+	replay  bool
+	used    []bool
+	host    string
+	secrets []string
+	next    http.RoundTripper
+}
+
+// NewRecorder creates recorder for capturing real API interactions
+func NewRecorder() *Recorder {
+	r := &Recorder{}
+	for _, kv := range os.Environ() {
+		pair := strings.SplitN(kv, "=", 2)
+		if len(pair) == 2 && len(pair[1]) >= minSecretLength && sensitiveEnvNames.MatchString(pair[0]) {
+			r.secrets = append(r.secrets, pair[1])
+		}
+	}
+	return r
+}
+
+// LoadRecorder creates recorder, that replays interactions from cassette at path
+func LoadRecorder(path string) (*Recorder, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &Recorder{replay: true}
+	if err = json.Unmarshal(raw, &r.Cassette); err != nil {
+		return nil, fmt.Errorf("cannot load cassette %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.Cassette.Interactions))
+	return r, nil
+}
+
+// Replaying returns true if recorder doesn't reach the network
+func (r *Recorder) Replaying() bool {
+	return r.replay
+}
+
+// Save writes sanitized cassette to path
+func (r *Recorder) Save(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cassette := r.Cassette
+	cassette.Configs = make([]string, len(r.Cassette.Configs))
+	for i, config := range r.Cassette.Configs {
+		cassette.Configs[i] = r.sanitize(config)
+	}
+	raw, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, raw, 0644)
+}
+
+func (r *Recorder) sanitize(s string) string {
+	if r.host != "" {
+		s = strings.ReplaceAll(s, r.host, r.Cassette.Host)
+	}
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, "**REDACTED**")
+	}
+	s = sensitiveHCLFields.ReplaceAllString(s, `$1$2"**REDACTED**"`)
+	return sensitiveJSONFields.ReplaceAllString(s, `"$1": "**REDACTED**"`)
+}
+
+// RoundTrip records or replays single API call
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	path := req.URL.RequestURI()
+	request := r.sanitize(string(body))
+	if r.replay {
+		return r.replayed(req, path, request)
+	}
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(response))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Cassette.Interactions = append(r.Cassette.Interactions, Interaction{
+		Method:   req.Method,
+		Path:     r.sanitize(path),
+		Request:  request,
+		Status:   resp.StatusCode,
+		Response: r.sanitize(string(response)),
+	})
+	return resp, nil
+}
+
+// replayed returns the first unused interaction matching the request. Resources might be
+// created concurrently, so the order of interactions is not strict.
+func (r *Recorder) replayed(req *http.Request, path, request string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, v := range r.Cassette.Interactions {
+		if r.used[i] || v.Method != req.Method || v.Path != path || v.Request != request {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", v.Status, http.StatusText(v.Status)),
+			StatusCode: v.Status,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			Body:          ioutil.NopCloser(strings.NewReader(v.Response)),
+			ContentLength: int64(len(v.Response)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, path)
+}
+
+// WithRecorder makes client to record or replay all API calls. For replay, the client
+// is pointed to the host stored in cassette and needs no real credentials.
+func (c *DatabricksClient) WithRecorder(r *Recorder) {
+	if r.replay {
+		c.Host = r.Cassette.Host
+		if c.Token == "" {
+			c.Token = "replay"
+		}
+	} else if r.Cassette.Host == "" {
+		r.host = strings.TrimSuffix(c.Host, "/")
+		r.host = strings.TrimPrefix(strings.TrimPrefix(r.host, "https://"), "http://")
+		r.Cassette.Host = "replay.cloud.databricks.com"
+		if c.IsAzure() {
+			r.Cassette.Host = "replay.azuredatabricks.net"
+		}
+	}
+	if c.httpClient == nil {
+		c.configureHTTPCLient()
+	}
+	r.next = c.httpClient.HTTPClient.Transport
+	c.httpClient.HTTPClient.Transport = r
+}
@@ -0,0 +1,118 @@
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			if req.RequestURI == "/api/2.0/token/create" {
+				_, err := rw.Write([]byte(`{"token_value": "dapi123", "token_info": {"token_id": "abc"}}`))
+				assert.NoError(t, err)
+				return
+			}
+			rw.WriteHeader(404)
+			_, err := rw.Write([]byte(`{"error_code": "NOT_FOUND", "message": "Nope"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	recorder := NewRecorder()
+	client := DatabricksClient{
+		Host:  server.URL + "/",
+		Token: "..",
+	}
+	require.NoError(t, client.Configure())
+	client.WithRecorder(recorder)
+
+	ctx := context.Background()
+	var token map[string]interface{}
+	err := client.Post(ctx, "/token/create", map[string]string{
+		"comment": "test",
+	}, &token)
+	require.NoError(t, err)
+	assert.Equal(t, "dapi123", token["token_value"], "recording must not change responses")
+
+	err = client.Get(ctx, "/clusters/get", map[string]string{
+		"cluster_id": "missing",
+	}, nil)
+	assert.EqualError(t, err, "Nope")
+
+	path := filepath.Join(t.TempDir(), "cassettes", "test.json")
+	require.NoError(t, recorder.Save(path))
+	raw, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "dapi123")
+	assert.NotContains(t, string(raw), server.URL[len("http://"):])
+
+	replayer, err := LoadRecorder(path)
+	require.NoError(t, err)
+	assert.True(t, replayer.Replaying())
+	replayClient := DatabricksClient{}
+	require.NoError(t, replayClient.Configure())
+	replayClient.WithRecorder(replayer)
+	assert.Equal(t, "replay.cloud.databricks.com", replayClient.Host)
+
+	err = replayClient.Get(ctx, "/clusters/get", map[string]string{
+		"cluster_id": "missing",
+	}, nil)
+	assert.EqualError(t, err, "Nope")
+
+	err = replayClient.Post(ctx, "/token/create", map[string]string{
+		"comment": "test",
+	}, &token)
+	require.NoError(t, err)
+	assert.Equal(t, "**REDACTED**", token["token_value"])
+
+	err = replayClient.Post(ctx, "/token/create", map[string]string{
+		"comment": "test",
+	}, &token)
+	assert.Error(t, err, "each interaction is replayed only once")
+}
+
+func TestLoadRecorder_Missing(t *testing.T) {
+	_, err := LoadRecorder(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestRecorder_SaveSanitizesConfigs(t *testing.T) {
+	defer CleanupEnvironment()()
+	os.Setenv("TEST_SECRET_VALUE", "very-secret-value")
+	recorder := NewRecorder()
+	client := DatabricksClient{
+		Host:  "https://abc.cloud.databricks.com",
+		Token: "..",
+	}
+	require.NoError(t, client.Configure())
+	client.WithRecorder(recorder)
+	recorder.Cassette.Configs = append(recorder.Cassette.Configs, `
+	provider "databricks" {
+		host  = "https://abc.cloud.databricks.com"
+		token = "dapi123"
+	}
+	resource "databricks_secret" "this" {
+		key          = "password"
+		string_value = "very-secret-value"
+		scope        = "very-secret-value-scope"
+	}`)
+
+	path := filepath.Join(t.TempDir(), "test.json")
+	require.NoError(t, recorder.Save(path))
+	raw, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "very-secret-value")
+	assert.NotContains(t, string(raw), "dapi123")
+	assert.NotContains(t, string(raw), "abc.cloud.databricks.com")
+	assert.Contains(t, string(raw), "replay.cloud.databricks.com")
+	assert.Contains(t, recorder.Cassette.Configs[0], "very-secret-value",
+		"configs of running test are not changed")
+}
@@ -90,6 +90,17 @@ func handleComputed(typeField reflect.StructField, schema *schema.Schema) {
 	}
 }
 
+// diffSuppressor keeps the value from the platform, when attribute or block is not
+// set in configuration. It's enabled by `suppress_diff` tag.
+func diffSuppressor(k, old, new string, d *schema.ResourceData) bool {
+	isCount := strings.HasSuffix(k, ".#") || strings.HasSuffix(k, ".%")
+	if new == "" || (isCount && new == "0") {
+		log.Printf("[DEBUG] Suppressing diff for %v: platform=%#v config=%#v", k, old, new)
+		return true
+	}
+	return false
+}
+
 func getAlias(typeField reflect.StructField) string {
 	tfTags := strings.Split(typeField.Tag.Get("tf"), ",")
 	for _, tag := range tfTags {
@@ -131,6 +142,14 @@ func typeToSchema(v reflect.Value, t reflect.Type) map[string]*schema.Schema {
 		}
 		scm[fieldName] = &schema.Schema{}
 		for _, token := range strings.Split(tfTag, ",") {
+			switch token {
+			case "force_new":
+				scm[fieldName].ForceNew = true
+			case "sensitive":
+				scm[fieldName].Sensitive = true
+			case "suppress_diff":
+				scm[fieldName].DiffSuppressFunc = diffSuppressor
+			}
 			colonSplit := strings.Split(token, ":")
 			if len(colonSplit) == 2 {
 				tfKey := colonSplit[0]
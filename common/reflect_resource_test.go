@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestReflectKind(t *testing.T) {
@@ -158,6 +159,31 @@ func TestStructToSchema_required_values_set(t *testing.T) {
 	}
 }
 
+func TestStructToSchema_extra_tags(t *testing.T) {
+	type connection struct {
+		Host     string   `json:"host" tf:"force_new"`
+		Password string   `json:"password,omitempty" tf:"sensitive,force_new"`
+		Zone     string   `json:"zone,omitempty" tf:"computed,suppress_diff"`
+		Tags     *testPtr `json:"tags,omitempty" tf:"suppress_diff"`
+	}
+	s := StructToSchema(connection{}, nil)
+	assert.True(t, s["host"].ForceNew)
+	assert.False(t, s["host"].Sensitive)
+	assert.True(t, s["password"].ForceNew)
+	assert.True(t, s["password"].Sensitive)
+	assert.True(t, s["zone"].Computed)
+	assert.Nil(t, s["password"].DiffSuppressFunc)
+
+	suppress := s["zone"].DiffSuppressFunc
+	require.NotNil(t, suppress)
+	assert.True(t, suppress("zone", "us-east-1a", "", nil))
+	assert.False(t, suppress("zone", "us-east-1a", "us-east-1b", nil))
+	suppress = s["tags"].DiffSuppressFunc
+	require.NotNil(t, suppress)
+	assert.True(t, suppress("tags.#", "1", "0", nil))
+	assert.False(t, suppress("tags.#", "0", "1", nil))
+}
+
 func TestStructToSchema_base_values_are_set(t *testing.T) {
 	for _, field := range testStructFields {
 		_, ok := scm[field]
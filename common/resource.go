@@ -14,7 +14,7 @@ type Resource struct {
 	Read           func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error
 	Update         func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error
 	Delete         func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error
-	CustomizeDiff  func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error
+	CustomizeDiff  func(ctx context.Context, d *schema.ResourceDiff, c *DatabricksClient) error
 	StateUpgraders []schema.StateUpgrader
 	Schema         map[string]*schema.Schema
 	SchemaVersion  int
@@ -28,10 +28,10 @@ func (r Resource) ToResource() *schema.Resource {
 		update = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			c := m.(*DatabricksClient)
 			if err := r.Update(ctx, d, c); err != nil {
-				return diag.FromErr(err)
+				return DiagnosticsFromErr(err)
 			}
 			if err := r.Read(ctx, d, c); err != nil {
-				return diag.FromErr(err)
+				return DiagnosticsFromErr(err)
 			}
 			return nil
 		}
@@ -44,6 +44,14 @@ func (r Resource) ToResource() *schema.Resource {
 			v.ForceNew = true
 		}
 	}
+	var customizeDiff schema.CustomizeDiffFunc
+	if r.CustomizeDiff != nil {
+		customizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			// client is nil, when diff is calculated without configured provider
+			c, _ := m.(*DatabricksClient)
+			return r.CustomizeDiff(ctx, d, c)
+		}
+	}
 	read := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		err := r.Read(ctx, d, m.(*DatabricksClient))
 		if e, ok := err.(APIError); ok && e.IsMissing() {
@@ -53,7 +61,7 @@ func (r Resource) ToResource() *schema.Resource {
 			return nil
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return DiagnosticsFromErr(err)
 		}
 		return nil
 	}
@@ -61,15 +69,15 @@ func (r Resource) ToResource() *schema.Resource {
 		Schema:         r.Schema,
 		SchemaVersion:  r.SchemaVersion,
 		StateUpgraders: r.StateUpgraders,
-		CustomizeDiff:  r.CustomizeDiff,
+		CustomizeDiff:  customizeDiff,
 		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			c := m.(*DatabricksClient)
 			err := r.Create(ctx, d, c)
 			if err != nil {
-				return diag.FromErr(err)
+				return DiagnosticsFromErr(err)
 			}
 			if err = r.Read(ctx, d, c); err != nil {
-				return diag.FromErr(err)
+				return DiagnosticsFromErr(err)
 			}
 			return nil
 		},
@@ -77,7 +85,7 @@ func (r Resource) ToResource() *schema.Resource {
 		UpdateContext: update,
 		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			if err := r.Delete(ctx, d, m.(*DatabricksClient)); err != nil {
-				return diag.FromErr(err)
+				return DiagnosticsFromErr(err)
 			}
 			return nil
 		},
@@ -2,9 +2,11 @@ package common
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -65,3 +67,34 @@ func TestUpdate(t *testing.T) {
 	assert.False(t, r.Schema["foo"].ForceNew)
 	assert.Equal(t, "", d.Id())
 }
+
+func TestCustomizeDiff(t *testing.T) {
+	r := Resource{
+		CustomizeDiff: func(ctx context.Context,
+			d *schema.ResourceDiff,
+			c *DatabricksClient) error {
+			if d.Get("foo").(int) > 1 && !c.IsAzure() {
+				return fmt.Errorf("foo must be at most 1 on %s", c.Host)
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}.ToResource()
+	client := &DatabricksClient{Host: "abc.cloud.databricks.com"}
+	config := func(foo int) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"foo": foo,
+		})
+	}
+	_, err := r.Diff(context.Background(), &terraform.InstanceState{}, config(2), client)
+	assert.EqualError(t, err, "foo must be at most 1 on abc.cloud.databricks.com")
+
+	diff, err := r.Diff(context.Background(), &terraform.InstanceState{}, config(1), client)
+	require.NoError(t, err)
+	assert.Equal(t, "1", diff.Attributes["foo"].New)
+}
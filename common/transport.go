@@ -0,0 +1,90 @@
+package common
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	// maxTransportRetries is the number of times idempotent request is sent again
+	// after network-level failure, before error is returned to the caller
+	maxTransportRetries = 3
+
+	// transportRetryDelay is multiplied by the number of the attempt
+	transportRetryDelay = 250 * time.Millisecond
+
+	idempotentMethods = map[string]bool{
+		"GET":     true,
+		"HEAD":    true,
+		"OPTIONS": true,
+		"PUT":     true,
+		"DELETE":  true,
+	}
+)
+
+// retryingTransport sends idempotent requests again on transient network errors, like
+// dropped keep-alive connections or DNS blips. API-level errors are handled by the
+// retry policy of the client.
+type retryingTransport struct {
+	next http.RoundTripper
+}
+
+func (t retryingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	for attempt := 1; err != nil && attempt <= maxTransportRetries; attempt++ {
+		if !canRetryTransport(req, err) {
+			return resp, err
+		}
+		log.Printf("[INFO] Retrying %s %s (%d of %d) because of network error: %s",
+			req.Method, req.URL.Path, attempt, maxTransportRetries, err)
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(time.Duration(attempt) * transportRetryDelay):
+		}
+		if req.GetBody != nil {
+			body, berr := req.GetBody()
+			if berr != nil {
+				return nil, berr
+			}
+			req.Body = body
+		}
+		resp, err = t.next.RoundTrip(req)
+	}
+	return resp, err
+}
+
+func canRetryTransport(req *http.Request, err error) bool {
+	if !idempotentMethods[req.Method] || req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// body is already consumed and cannot be sent again
+		return false
+	}
+	return isTransientNetworkError(err)
+}
+
+// isTransientNetworkError returns true for errors, that happen before response
+// from the API is received and that are likely to disappear on the next attempt
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var dnsError *net.DNSError
+	if errors.As(err, &dnsError) {
+		return true
+	}
+	var netError net.Error
+	if errors.As(err, &netError) && netError.Timeout() {
+		return true
+	}
+	return strings.Contains(err.Error(), "connection reset by peer")
+}
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyServer drops connection without response for the first failures requests
+func flakyServer(t *testing.T, failures int32) (*DatabricksClient, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			if atomic.AddInt32(&calls, 1) <= failures {
+				conn, _, err := rw.(http.Hijacker).Hijack()
+				require.NoError(t, err)
+				conn.Close()
+				return
+			}
+			_, err := rw.Write([]byte(`{"foo": "bar"}`))
+			assert.NoError(t, err)
+		}))
+	t.Cleanup(server.Close)
+	delay := transportRetryDelay
+	transportRetryDelay = time.Millisecond
+	t.Cleanup(func() {
+		transportRetryDelay = delay
+	})
+	client := &DatabricksClient{
+		Host:  server.URL,
+		Token: "..",
+	}
+	require.NoError(t, client.Configure())
+	return client, &calls
+}
+
+func TestRetryingTransport_IdempotentRequestRetried(t *testing.T) {
+	client, calls := flakyServer(t, 2)
+	var response map[string]string
+	err := client.Get(context.Background(), "/a", nil, &response)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", response["foo"])
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestRetryingTransport_Bounded(t *testing.T) {
+	client, calls := flakyServer(t, 100)
+	err := client.Get(context.Background(), "/a", nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, int32(maxTransportRetries+1), atomic.LoadInt32(calls))
+}
+
+func TestRetryingTransport_PostNotRetried(t *testing.T) {
+	client, calls := flakyServer(t, 1)
+	err := client.Post(context.Background(), "/a", map[string]string{
+		"a": "b",
+	}, nil)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestIsTransientNetworkError(t *testing.T) {
+	for _, err := range []error{
+		io.EOF,
+		fmt.Errorf("wrapped: %w", io.ErrUnexpectedEOF),
+		&net.OpError{Op: "read", Err: syscall.ECONNRESET},
+		&net.DNSError{Err: "no such host", Name: "abc", IsTemporary: true},
+		fmt.Errorf("read tcp: connection reset by peer"),
+	} {
+		assert.True(t, isTransientNetworkError(err), err.Error())
+	}
+	assert.False(t, isTransientNetworkError(fmt.Errorf("x509: certificate signed by unknown authority")))
+	assert.False(t, isTransientNetworkError(context.Canceled))
+}
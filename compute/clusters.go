@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -14,7 +15,7 @@ import (
 )
 
 func (a ClustersAPI) defaultTimeout() time.Duration {
-	return 30 * time.Minute
+	return common.TimeoutFromContext(a.context, 30*time.Minute)
 }
 
 // NewClustersAPI creates ClustersAPI instance from provider meta
@@ -296,7 +297,7 @@ func (a ClustersAPI) List() ([]ClusterInfo, error) {
 
 // ListNodeTypes returns a sorted list of supported Spark node types
 func (a ClustersAPI) ListNodeTypes() (l NodeTypeList, err error) {
-	err = a.client.Get(a.context, "/clusters/list-node-types", nil, &l)
+	err = a.client.GetCached(a.context, "/clusters/list-node-types", &l)
 	return
 }
 
@@ -368,11 +369,14 @@ type NodeTypeRequest struct {
 	MinCores              int32  `json:"min_cores,omitempty"`
 	MinGPUs               int32  `json:"min_gpus,omitempty"`
 	LocalDisk             bool   `json:"local_disk,omitempty"`
+	LocalDiskMinSize      int32  `json:"local_disk_min_size,omitempty"`
 	Category              string `json:"category,omitempty"`
 	PhotonWorkerCapable   bool   `json:"photon_worker_capable,omitempty"`
 	PhotonDriverCapable   bool   `json:"photon_driver_capable,omitempty"`
 	IsIOCacheEnabled      bool   `json:"is_io_cache_enabled,omitempty"`
 	SupportPortForwarding bool   `json:"support_port_forwarding,omitempty"`
+	Graviton              bool   `json:"graviton,omitempty"`
+	Fleet                 bool   `json:"fleet,omitempty"`
 }
 
 func defaultSmallestNodeType(a ClustersAPI) string {
@@ -412,7 +416,19 @@ func (a ClustersAPI) GetSmallestNodeType(r NodeTypeRequest) string {
 				nt.NodeInstanceType.LocalNVMeDisks < 1) {
 			continue
 		}
-		if r.Category != "" && nt.Category != r.Category {
+		if r.LocalDiskMinSize > 0 && nt.NodeInstanceType != nil &&
+			(nt.NodeInstanceType.LocalDisks*nt.NodeInstanceType.LocalDiskSizeGB+
+				nt.NodeInstanceType.LocalNVMeDisks*nt.NodeInstanceType.LocalNVMeDiskSizeGB) < r.LocalDiskMinSize {
+			continue
+		}
+		if r.Category != "" && !strings.EqualFold(nt.Category, r.Category) {
+			continue
+		}
+		if nt.IsGraviton != r.Graviton {
+			// ARM nodes require special runtimes, so they are picked only on request
+			continue
+		}
+		if nt.IsFleet() != r.Fleet {
 			continue
 		}
 		if r.IsIOCacheEnabled && nt.IsIOCacheEnabled != r.IsIOCacheEnabled {
@@ -435,10 +451,36 @@ func (a ClustersAPI) GetSmallestNodeType(r NodeTypeRequest) string {
 // ListSparkVersions returns smallest (or default) node type id given the criteria
 func (a ClustersAPI) ListSparkVersions() (SparkVersionsList, error) {
 	var sparkVersions SparkVersionsList
-	err := a.client.Get(a.context, "/clusters/spark-versions", nil, &sparkVersions)
+	err := a.client.GetCached(a.context, "/clusters/spark-versions", &sparkVersions)
 	return sparkVersions, err
 }
 
+// runtimeVersionLess compares runtime keys, like `10.4.x-scala2.12`, by their numeric
+// major and minor parts, so that `10.4.x` is newer than `9.1.x`
+func runtimeVersionLess(a, b string) bool {
+	av, bv := runtimeVersionParts(a), runtimeVersionParts(b)
+	for i := 0; i < len(av) && i < len(bv); i++ {
+		if av[i] != bv[i] {
+			return av[i] < bv[i]
+		}
+	}
+	if len(av) != len(bv) {
+		return len(av) < len(bv)
+	}
+	return a < b
+}
+
+func runtimeVersionParts(version string) (parts []int) {
+	for _, part := range strings.Split(strings.SplitN(version, "-", 2)[0], ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return
+}
+
 // LatestSparkVersion returns latest version matching the request parameters
 func (sparkVersions SparkVersionsList) LatestSparkVersion(req SparkVersionRequest) (string, error) {
 	var versions []string
@@ -449,6 +491,7 @@ func (sparkVersions SparkVersionsList) LatestSparkVersion(req SparkVersionReques
 				(strings.Contains(version.Version, "-ml-") == req.ML) &&
 				(strings.Contains(version.Version, "-hls-") == req.Genomics) &&
 				(strings.Contains(version.Version, "-gpu-") == req.GPU) &&
+				(strings.Contains(version.Version, "-photon-") == req.Photon) &&
 				(strings.Contains(version.Description, "Beta") == req.Beta))
 			if matches && req.LongTermSupport {
 				matches = (matches && strings.Contains(version.Description, "LTS"))
@@ -465,7 +508,9 @@ func (sparkVersions SparkVersionsList) LatestSparkVersion(req SparkVersionReques
 		return "", fmt.Errorf("spark versions query returned no results. Please change your search criteria and try again")
 	} else if len(versions) > 1 {
 		if req.Latest {
-			sort.Sort(sort.Reverse(sort.StringSlice(versions)))
+			sort.Slice(versions, func(i, j int) bool {
+				return runtimeVersionLess(versions[j], versions[i])
+			})
 		} else {
 			return "", fmt.Errorf("spark versions query returned multiple results. Please change your search criteria and try again")
 		}
@@ -1125,3 +1125,83 @@ func TestListNodeTypes(t *testing.T) {
 	nodeType = api.GetSmallestNodeType(NodeTypeRequest{Category: "Storage Optimized"})
 	assert.Equal(t, nodeType, defaultSmallestNodeType(api))
 }
+
+func TestListNodeTypes_GravitonFleetAndDiskSize(t *testing.T) {
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/list-node-types",
+			Response: NodeTypeList{
+				[]NodeType{
+					{
+						NodeTypeID:     "m6gd.large",
+						InstanceTypeID: "m6gd.large",
+						MemoryMB:       8192,
+						NumCores:       2,
+						IsGraviton:     true,
+						Category:       "General Purpose",
+						NodeInstanceType: &NodeInstanceType{
+							LocalNVMeDisks:      1,
+							LocalNVMeDiskSizeGB: 118,
+						},
+					},
+					{
+						NodeTypeID:     "md-fleet.xlarge",
+						InstanceTypeID: "m5d.xlarge",
+						MemoryMB:       16384,
+						NumCores:       4,
+						Category:       "General Purpose",
+						NodeInstanceType: &NodeInstanceType{
+							LocalNVMeDisks:      1,
+							LocalNVMeDiskSizeGB: 150,
+						},
+					},
+					{
+						NodeTypeID:     "m5d.xlarge",
+						InstanceTypeID: "m5d.xlarge",
+						MemoryMB:       16384,
+						NumCores:       4,
+						Category:       "General Purpose",
+						NodeInstanceType: &NodeInstanceType{
+							LocalNVMeDisks:      1,
+							LocalNVMeDiskSizeGB: 150,
+						},
+					},
+					{
+						NodeTypeID:     "m5dn.xlarge",
+						InstanceTypeID: "m5d.xlarge",
+						MemoryMB:       16384,
+						NumCores:       4,
+						Category:       "General Purpose",
+						NodeInstanceType: &NodeInstanceType{
+							LocalNVMeDisks:      1,
+							LocalNVMeDiskSizeGB: 150,
+						},
+					},
+					{
+						NodeTypeID:     "i3.2xlarge",
+						InstanceTypeID: "i3.2xlarge",
+						MemoryMB:       62464,
+						NumCores:       8,
+						Category:       "Storage Optimized",
+						NodeInstanceType: &NodeInstanceType{
+							LocalNVMeDisks:      1,
+							LocalNVMeDiskSizeGB: 1900,
+						},
+					},
+				},
+			},
+		},
+	})
+	defer server.Close()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	api := NewClustersAPI(ctx, client)
+	assert.Equal(t, "m5d.xlarge", api.GetSmallestNodeType(NodeTypeRequest{}))
+	assert.Equal(t, "m6gd.large", api.GetSmallestNodeType(NodeTypeRequest{Graviton: true}))
+	assert.Equal(t, "md-fleet.xlarge", api.GetSmallestNodeType(NodeTypeRequest{Fleet: true}))
+	assert.Equal(t, "i3.2xlarge", api.GetSmallestNodeType(NodeTypeRequest{LocalDiskMinSize: 500}))
+	assert.Equal(t, "i3.2xlarge", api.GetSmallestNodeType(NodeTypeRequest{Category: "storage optimized"}))
+}
@@ -0,0 +1,75 @@
+package compute
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type clusterLibraryStatus struct {
+	Type                            string   `json:"type,omitempty" tf:"computed"`
+	Key                             string   `json:"key,omitempty" tf:"computed"`
+	Status                          string   `json:"status,omitempty" tf:"computed"`
+	Messages                        []string `json:"messages,omitempty" tf:"computed"`
+	IsLibraryInstalledOnAllClusters bool     `json:"is_library_for_all_clusters,omitempty" tf:"computed"`
+}
+
+type clusterLibraryStatusData struct {
+	ClusterID string                 `json:"cluster_id"`
+	Libraries []clusterLibraryStatus `json:"libraries,omitempty" tf:"computed"`
+	Installed []string               `json:"installed,omitempty" tf:"computed"`
+	Failed    []string               `json:"failed,omitempty" tf:"computed"`
+}
+
+// DataSourceClusterLibraryStatus returns installation status of every library on the cluster
+func DataSourceClusterLibraryStatus() *schema.Resource {
+	s := common.StructToSchema(clusterLibraryStatusData{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData,
+			m interface{}) diag.Diagnostics {
+			var data clusterLibraryStatusData
+			err := common.DataToStructPointer(d, s, &data)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			cls, err := NewLibrariesAPI(ctx, m).ClusterStatus(data.ClusterID)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			data.Installed = []string{}
+			data.Failed = []string{}
+			for _, status := range cls.LibraryStatuses {
+				if status.Library == nil {
+					continue
+				}
+				libraryType, key := status.Library.TypeAndKey()
+				data.Libraries = append(data.Libraries, clusterLibraryStatus{
+					Type:                            strings.TrimPrefix(libraryType, "library_"),
+					Key:                             key,
+					Status:                          status.Status,
+					Messages:                        status.Messages,
+					IsLibraryInstalledOnAllClusters: status.IsLibraryInstalledOnAllClusters,
+				})
+				switch {
+				case status.isFailed():
+					data.Failed = append(data.Failed, key)
+				case status.Status == "INSTALLED":
+					data.Installed = append(data.Installed, key)
+				}
+			}
+			err = common.StructToData(data, s, d)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			d.SetId(data.ClusterID)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceClusterLibraryStatus(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{
+					ClusterID: "abc",
+					LibraryStatuses: []LibraryStatus{
+						{
+							Library: &Library{
+								Pypi: &PyPi{
+									Package: "requests",
+								},
+							},
+							Status: "INSTALLED",
+						},
+						{
+							Library: &Library{
+								Whl: "dbfs:/FileStore/app.whl",
+							},
+							Status:   "FAILED",
+							Messages: []string{"Library installation failed"},
+						},
+						{
+							Library: &Library{
+								Jar: "dbfs:/FileStore/all.jar",
+							},
+							Status: "INSTALLED",
+
+							IsLibraryInstalledOnAllClusters: true,
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceClusterLibraryStatus(),
+		HCL:         `cluster_id = "abc"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 3, d.Get("libraries.#"))
+	assert.Equal(t, "pypi", d.Get("libraries.0.type"))
+	assert.Equal(t, "requests", d.Get("libraries.0.key"))
+	assert.Equal(t, "Library installation failed", d.Get("libraries.1.messages.0"))
+	assert.Equal(t, true, d.Get("libraries.2.is_library_for_all_clusters"))
+	assert.Equal(t, []interface{}{"requests", "dbfs:/FileStore/all.jar"}, d.Get("installed"))
+	assert.Equal(t, []interface{}{"dbfs:/FileStore/app.whl"}, d.Get("failed"))
+}
+
+func TestDataSourceClusterLibraryStatus_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Status:   404,
+				Response: map[string]string{
+					"error_code": "RESOURCE_DOES_NOT_EXIST",
+					"message":    "Cluster abc does not exist",
+				},
+			},
+		},
+		Resource:    DataSourceClusterLibraryStatus(),
+		HCL:         `cluster_id = "abc"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "Cluster abc does not exist")
+}
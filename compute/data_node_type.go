@@ -21,7 +21,7 @@ func DataSourceNodeType() *schema.Resource {
 			var this NodeTypeRequest
 			err := common.DataToStructPointer(d, s, &this)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			clustersAPI := NewClustersAPI(ctx, m)
 			d.SetId(clustersAPI.GetSmallestNodeType(this))
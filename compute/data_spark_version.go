@@ -21,11 +21,11 @@ func DataSourceSparkVersion() *schema.Resource {
 			var this SparkVersionRequest
 			err := common.DataToStructPointer(d, s, &this)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			version, err := NewClustersAPI(ctx, m).LatestSparkVersion(this)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			d.SetId(version)
 			return nil
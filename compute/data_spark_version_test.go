@@ -194,3 +194,93 @@ func TestSparkVersionErrorBadAnswer(t *testing.T) {
 	assert.Error(t, err)
 	require.Equal(t, true, strings.Contains(err.Error(), "Invalid JSON received"))
 }
+
+func TestSparkVersionLatest_Semantic(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/spark-versions",
+				Response: SparkVersionsList{
+					SparkVersions: []SparkVersion{
+						{
+							Version:     "9.1.x-gpu-ml-scala2.12",
+							Description: "9.1 LTS ML (includes Apache Spark 3.1.2, GPU, Scala 2.12)",
+						},
+						{
+							Version:     "10.4.x-gpu-ml-scala2.12",
+							Description: "10.4 LTS ML (includes Apache Spark 3.2.1, GPU, Scala 2.12)",
+						},
+						{
+							Version:     "10.3.x-gpu-ml-scala2.12",
+							Description: "10.3 ML (includes Apache Spark 3.2.1, GPU, Scala 2.12)",
+						},
+						{
+							Version:     "10.4.x-photon-scala2.12",
+							Description: "10.4 LTS Photon (includes Apache Spark 3.2.1, Scala 2.12)",
+						},
+						{
+							Version:     "9.1.x-photon-scala2.12",
+							Description: "9.1 LTS Photon (includes Apache Spark 3.1.2, Scala 2.12)",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceSparkVersion(),
+		NonWritable: true,
+		State: map[string]interface{}{
+			"long_term_support": true,
+			"ml":                true,
+			"gpu":               true,
+		},
+		ID: ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.4.x-gpu-ml-scala2.12", d.Id())
+}
+
+func TestSparkVersionPhoton(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/spark-versions",
+				Response: SparkVersionsList{
+					SparkVersions: []SparkVersion{
+						{
+							Version:     "10.4.x-scala2.12",
+							Description: "10.4 LTS (includes Apache Spark 3.2.1, Scala 2.12)",
+						},
+						{
+							Version:     "10.4.x-photon-scala2.12",
+							Description: "10.4 LTS Photon (includes Apache Spark 3.2.1, Scala 2.12)",
+						},
+						{
+							Version:     "9.1.x-photon-scala2.12",
+							Description: "9.1 LTS Photon (includes Apache Spark 3.1.2, Scala 2.12)",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceSparkVersion(),
+		NonWritable: true,
+		State: map[string]interface{}{
+			"photon":        true,
+			"spark_version": "3.1",
+		},
+		ID: ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "9.1.x-photon-scala2.12", d.Id())
+}
+
+func TestRuntimeVersionLess(t *testing.T) {
+	assert.True(t, runtimeVersionLess("9.1.x-scala2.12", "10.4.x-scala2.12"))
+	assert.True(t, runtimeVersionLess("10.3.x-scala2.12", "10.4.x-scala2.12"))
+	assert.False(t, runtimeVersionLess("11.0.x-scala2.12", "10.4.x-scala2.12"))
+	assert.True(t, runtimeVersionLess("7.x-snapshot-scala2.12", "7.3.x-scala2.12"))
+}
@@ -3,24 +3,63 @@ package compute
 import (
 	"context"
 
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// DataSourceClusterZones ...
+// zonePlacementOptions are values of `zone_id`, that let Databricks place nodes of the cluster
+var zonePlacementOptions = map[string][]string{
+	"aws":   {"auto"},
+	"azure": {},
+	"gcp":   {"HA", "AUTO"},
+}
+
+func cloudOf(c *common.DatabricksClient) string {
+	switch {
+	case c.IsAzure():
+		return "azure"
+	case c.IsGcp():
+		return "gcp"
+	default:
+		return "aws"
+	}
+}
+
+// DataSourceClusterZones returns availability zones and placement options of the workspace
 func DataSourceClusterZones() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			zonesInfo, err := NewClustersAPI(ctx, m).ListZones()
-			if err != nil {
-				return diag.FromErr(err)
+			cloud := cloudOf(m.(*common.DatabricksClient))
+			var zonesInfo ZonesInfo
+			// Azure Databricks distributes nodes across availability zones of the region
+			// by itself, so there are no zones to choose from
+			if cloud != "azure" {
+				var err error
+				zonesInfo, err = NewClustersAPI(ctx, m).ListZones()
+				if err != nil {
+					return common.DiagnosticsFromErr(err)
+				}
+			}
+			if zonesInfo.Zones == nil {
+				zonesInfo.Zones = []string{}
+			}
+			if zonesInfo.DefaultZone != "" {
+				d.SetId(zonesInfo.DefaultZone)
+			} else {
+				d.SetId(cloud)
+			}
+			if err := d.Set("default_zone", zonesInfo.DefaultZone); err != nil {
+				return common.DiagnosticsFromErr(err)
 			}
-			d.SetId(zonesInfo.DefaultZone)
-			if err = d.Set("default_zone", zonesInfo.DefaultZone); err != nil {
-				return diag.FromErr(err)
+			if err := d.Set("zones", zonesInfo.Zones); err != nil {
+				return common.DiagnosticsFromErr(err)
 			}
-			if err = d.Set("zones", zonesInfo.Zones); err != nil {
-				return diag.FromErr(err)
+			if err := d.Set("cloud", cloud); err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			if err := d.Set("placement_options", zonePlacementOptions[cloud]); err != nil {
+				return common.DiagnosticsFromErr(err)
 			}
 			return nil
 		},
@@ -36,6 +75,15 @@ func DataSourceClusterZones() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				ForceNew: true,
 			},
+			"cloud": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"placement_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
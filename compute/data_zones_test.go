@@ -3,6 +3,7 @@ package compute
 import (
 	"testing"
 
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
 )
@@ -27,4 +28,28 @@ func TestZones(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "a", d.Get("default_zone"))
 	assert.Equal(t, 2, d.Get("zones.#"))
+	assert.Equal(t, "aws", d.Get("cloud"))
+	assert.Equal(t, []interface{}{"auto"}, d.Get("placement_options"))
+}
+
+func TestZones_Azure(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataSourceClusterZones(),
+		NonWritable: true,
+		Azure:       true,
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "azure", d.Id())
+	assert.Equal(t, "", d.Get("default_zone"))
+	assert.Equal(t, 0, d.Get("zones.#"))
+	assert.Equal(t, 0, d.Get("placement_options.#"))
+}
+
+func TestZones_Cloud(t *testing.T) {
+	assert.Equal(t, "gcp", cloudOf(&common.DatabricksClient{Host: "https://123.4.gcp.databricks.com"}))
+	assert.Equal(t, "azure", cloudOf(&common.DatabricksClient{Host: "https://adb-123.4.azuredatabricks.net"}))
+	assert.Equal(t, "aws", cloudOf(&common.DatabricksClient{Host: "https://abc.cloud.databricks.com"}))
+	assert.Equal(t, []string{"HA", "AUTO"}, zonePlacementOptions["gcp"])
 }
@@ -3,10 +3,13 @@ package compute
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // NewLibrariesAPI creates LibrariesAPI instance from provider meta
@@ -49,6 +52,19 @@ type Library struct { // TODO: discuss if we can make a dedicated entity just fo
 	Pypi  *PyPi  `json:"pypi,omitempty" tf:"group:lib"`
 	Maven *Maven `json:"maven,omitempty" tf:"group:lib"`
 	Cran  *Cran  `json:"cran,omitempty" tf:"group:lib"`
+	// requirements.txt file with Python dependencies, that is stored in workspace or volume
+	Requirements string `json:"requirements,omitempty" tf:"group:lib"`
+}
+
+// requirementsPathRegex matches workspace files and Unity Catalog volume paths
+var requirementsPathRegex = regexp.MustCompile(`^/(Workspace|Volumes)/.+`)
+
+// addLibrarySchemaValidation validates locations of library blocks in the given schema
+func addLibrarySchemaValidation(s map[string]*schema.Schema) {
+	if p, err := common.SchemaPath(s, "library", "requirements"); err == nil {
+		p.ValidateFunc = validation.StringMatch(requirementsPathRegex,
+			"requirements file must be in workspace (/Workspace/...) or in volume (/Volumes/...)")
+	}
 }
 
 // TypeAndKey can be used for computing differences
@@ -66,6 +82,8 @@ func (library Library) TypeAndKey() (string, string) {
 		return "library_maven", library.Maven.Coordinates + library.Maven.Repo + strings.Join(library.Maven.Exclusions, "")
 	case library.Cran != nil && len(library.Cran.Package) > 0:
 		return "library_cran", library.Cran.Package + library.Cran.Repo
+	case len(library.Requirements) > 0:
+		return "library_requirements", library.Requirements
 	}
 	return "", ""
 }
@@ -76,7 +94,10 @@ type ClusterLibraryList struct {
 	Libraries []Library `json:"libraries,omitempty" url:"libraries,omitempty" tf:"slice_set,alias:library"`
 }
 
-// Diff returns install/uninstall lists given a cluster lib status
+// Diff returns install/uninstall lists given a cluster lib status. Only changed libraries
+// are returned, so that unchanged ones are kept installed on running cluster. Libraries
+// installed on all clusters or already marked for removal are not considered. Failed
+// libraries are installed again.
 func (cll *ClusterLibraryList) Diff(cls ClusterLibraryStatuses) (ClusterLibraryList, ClusterLibraryList) {
 	inConfig := map[string]Library{}
 	for _, lib := range cll.Libraries {
@@ -84,16 +105,21 @@ func (cll *ClusterLibraryList) Diff(cls ClusterLibraryStatuses) (ClusterLibraryL
 		inConfig[key] = lib
 	}
 	inState := map[string]Library{}
+	failed := map[string]bool{}
 	for _, status := range cls.LibraryStatuses {
+		if !status.isManagedOnCluster() {
+			continue
+		}
 		lib := *status.Library
 		_, key := lib.TypeAndKey()
 		inState[key] = lib
+		failed[key] = status.isFailed()
 	}
 	toInstall := ClusterLibraryList{ClusterID: cll.ClusterID}
 	toUninstall := ClusterLibraryList{ClusterID: cll.ClusterID}
 	for key, lib := range inConfig {
 		_, exists := inState[key]
-		if exists {
+		if exists && !failed[key] {
 			continue
 		}
 		toInstall.Libraries = append(toInstall.Libraries, lib)
@@ -116,6 +142,29 @@ type LibraryStatus struct {
 	Messages                        []string `json:"messages,omitempty"`
 }
 
+// isManagedOnCluster is false for libraries, that cannot be changed for the single cluster
+// or that were already uninstalled and are just waiting for cluster restart
+func (status LibraryStatus) isManagedOnCluster() bool {
+	if status.Library == nil || status.IsLibraryInstalledOnAllClusters {
+		return false
+	}
+	return status.Status != "UNINSTALL_ON_RESTART"
+}
+
+// isFailed is true for libraries, that are not actually available on the cluster
+func (status LibraryStatus) isFailed() bool {
+	return status.Status == "FAILED"
+}
+
+func (status LibraryStatus) String() string {
+	libraryType, key := status.Library.TypeAndKey()
+	if len(status.Messages) == 0 {
+		return fmt.Sprintf("%s[%s] is %s", libraryType, key, status.Status)
+	}
+	return fmt.Sprintf("%s[%s] is %s: %s", libraryType, key, status.Status,
+		strings.Join(status.Messages, ", "))
+}
+
 // ClusterLibraryStatuses  A status will be available for all libraries installed on the cluster via the API or
 // the libraries UI as well as libraries set to be installed on all clusters via the libraries UI. If a library
 // has been set to be installed on all clusters, is_library_for_all_clusters will be true, even if the library
@@ -125,10 +174,14 @@ type ClusterLibraryStatuses struct {
 	LibraryStatuses []LibraryStatus `json:"library_statuses,omitempty"`
 }
 
-// ToLibraryList convert to envity for convenient comparison
+// ToLibraryList convert to envity for convenient comparison. Failed libraries are
+// not in the list, so that they are shown as missing and are installed again.
 func (cls ClusterLibraryStatuses) ToLibraryList() ClusterLibraryList {
 	cll := ClusterLibraryList{ClusterID: cls.ClusterID}
 	for _, lib := range cls.LibraryStatuses {
+		if !lib.isManagedOnCluster() || lib.isFailed() {
+			continue
+		}
 		cll.Libraries = append(cll.Libraries, *lib.Library)
 	}
 	sort.Slice(cll.Libraries, func(i, j int) bool {
@@ -146,11 +199,18 @@ func (cls ClusterLibraryStatuses) IsRetryNeeded() (bool, error) {
 	pending := 0
 	ready := 0
 	errors := []string{}
+	pendingLibraries := []string{}
 	for _, lib := range cls.LibraryStatuses {
 		if lib.IsLibraryInstalledOnAllClusters {
 			continue
 		}
 		switch lib.Status {
+		case "PENDING", "RESOLVING", "INSTALLING":
+			if lib.Library != nil {
+				pendingLibraries = append(pendingLibraries, lib.String())
+			}
+		}
+		switch lib.Status {
 		// No action has yet been taken to install the library. This state should be very short lived.
 		case "PENDING":
 			pending++
@@ -178,6 +238,10 @@ func (cls ClusterLibraryStatuses) IsRetryNeeded() (bool, error) {
 		}
 	}
 	if pending > 0 {
+		if len(pendingLibraries) > 0 {
+			return true, fmt.Errorf("%d libraries are ready, but there are still %d pending: %s",
+				ready, pending, strings.Join(pendingLibraries, ", "))
+		}
 		return true, fmt.Errorf("%d libraries are ready, but there are still %d pending", ready, pending)
 	}
 	if len(errors) > 0 {
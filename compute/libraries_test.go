@@ -102,6 +102,145 @@ func TestClusterLibraryStatuses_Errors(t *testing.T) {
 	assert.False(t, need)
 }
 
+func TestClusterLibraryStatuses_PendingDetails(t *testing.T) {
+	need, err := ClusterLibraryStatuses{
+		ClusterID: "abc",
+		LibraryStatuses: []LibraryStatus{
+			{
+				Status: "INSTALLED",
+				Library: &Library{
+					Jar: "a.jar",
+				},
+			},
+			{
+				Status: "RESOLVING",
+				Library: &Library{
+					Pypi: &PyPi{
+						Package: "requests",
+					},
+				},
+				Messages: []string{"Fetching metadata"},
+			},
+		},
+	}.IsRetryNeeded()
+	require.Error(t, err)
+	assert.Equal(t, "1 libraries are ready, but there are still 1 pending: "+
+		"library_pypi[requests] is RESOLVING: Fetching metadata", err.Error())
+	assert.True(t, need)
+}
+
+func TestClusterLibraryList_DiffOnlyChanged(t *testing.T) {
+	cll := ClusterLibraryList{
+		ClusterID: "abc",
+		Libraries: []Library{
+			{Jar: "a.jar"},
+			{Whl: "c.whl"},
+			{Egg: "d.egg"},
+		},
+	}
+	toInstall, toUninstall := cll.Diff(ClusterLibraryStatuses{
+		ClusterID: "abc",
+		LibraryStatuses: []LibraryStatus{
+			{
+				Library: &Library{Jar: "a.jar"},
+				Status:  "INSTALLED",
+			},
+			{
+				Library: &Library{Jar: "b.jar"},
+				Status:  "INSTALLED",
+			},
+			{
+				// removed and added back before restart
+				Library: &Library{Whl: "c.whl"},
+				Status:  "UNINSTALL_ON_RESTART",
+			},
+			{
+				Library: &Library{Egg: "e.egg"},
+				Status:  "INSTALLED",
+
+				IsLibraryInstalledOnAllClusters: true,
+			},
+		},
+	})
+	assert.Equal(t, "abc", toInstall.ClusterID)
+	assert.ElementsMatch(t, []Library{{Whl: "c.whl"}, {Egg: "d.egg"}}, toInstall.Libraries)
+	assert.Equal(t, []Library{{Jar: "b.jar"}}, toUninstall.Libraries)
+}
+
+func TestClusterLibraryList_DiffRequirements(t *testing.T) {
+	cll := ClusterLibraryList{
+		ClusterID: "abc",
+		Libraries: []Library{
+			{Requirements: "/Workspace/Shared/requirements-v2.txt"},
+		},
+	}
+	toInstall, toUninstall := cll.Diff(ClusterLibraryStatuses{
+		ClusterID: "abc",
+		LibraryStatuses: []LibraryStatus{
+			{
+				Library: &Library{Requirements: "/Workspace/Shared/requirements.txt"},
+				Status:  "INSTALLED",
+			},
+		},
+	})
+	assert.Equal(t, []Library{{Requirements: "/Workspace/Shared/requirements-v2.txt"}}, toInstall.Libraries)
+	assert.Equal(t, []Library{{Requirements: "/Workspace/Shared/requirements.txt"}}, toUninstall.Libraries)
+	libraryType, key := toInstall.Libraries[0].TypeAndKey()
+	assert.Equal(t, "library_requirements", libraryType)
+	assert.Equal(t, "/Workspace/Shared/requirements-v2.txt", key)
+}
+
+func TestClusterLibraryList_DiffReinstallsFailed(t *testing.T) {
+	cll := ClusterLibraryList{
+		ClusterID: "abc",
+		Libraries: []Library{
+			{Jar: "a.jar"},
+			{Whl: "c.whl"},
+		},
+	}
+	failedStatuses := ClusterLibraryStatuses{
+		ClusterID: "abc",
+		LibraryStatuses: []LibraryStatus{
+			{
+				Library: &Library{Jar: "a.jar"},
+				Status:  "INSTALLED",
+			},
+			{
+				Library: &Library{Whl: "c.whl"},
+				Status:  "FAILED",
+			},
+		},
+	}
+	toInstall, toUninstall := cll.Diff(failedStatuses)
+	assert.Equal(t, []Library{{Whl: "c.whl"}}, toInstall.Libraries)
+	assert.Len(t, toUninstall.Libraries, 0)
+	// failed library is shown as missing from the cluster
+	assert.Equal(t, []Library{{Jar: "a.jar"}}, failedStatuses.ToLibraryList().Libraries)
+}
+
+func TestClusterLibraryStatuses_ToLibraryListSkipsUnmanaged(t *testing.T) {
+	cll := ClusterLibraryStatuses{
+		ClusterID: "abc",
+		LibraryStatuses: []LibraryStatus{
+			{
+				Library: &Library{Jar: "b.jar"},
+				Status:  "INSTALLED",
+			},
+			{
+				Library: &Library{Jar: "a.jar"},
+				Status:  "UNINSTALL_ON_RESTART",
+			},
+			{
+				Library: &Library{Egg: "e.egg"},
+				Status:  "INSTALLED",
+
+				IsLibraryInstalledOnAllClusters: true,
+			},
+		},
+	}.ToLibraryList()
+	assert.Equal(t, []Library{{Jar: "b.jar"}}, cll.Libraries)
+}
+
 func TestAccLibraryCreate(t *testing.T) {
 	cloud := os.Getenv("CLOUD_ENV")
 	if cloud == "" {
@@ -150,3 +289,24 @@ func TestAccLibraryCreate(t *testing.T) {
 	assert.NoError(t, err, err)
 	assert.Equal(t, len(libraryStatusList.LibraryStatuses), len(libraries))
 }
+
+func TestClusterLibraryListDiff_InstallsMissing(t *testing.T) {
+	configured := ClusterLibraryList{
+		ClusterID: "abc",
+		Libraries: []Library{
+			{Whl: "dbfs:/FileStore/app.whl"},
+			{Jar: "dbfs:/FileStore/app.jar"},
+		},
+	}
+	toInstall, toUninstall := configured.Diff(ClusterLibraryStatuses{
+		ClusterID: "abc",
+		LibraryStatuses: []LibraryStatus{
+			{
+				Library: &Library{Jar: "dbfs:/FileStore/app.jar"},
+				Status:  "INSTALLED",
+			},
+		},
+	})
+	assert.Equal(t, []Library{{Whl: "dbfs:/FileStore/app.whl"}}, toInstall.Libraries)
+	assert.Len(t, toUninstall.Libraries, 0)
+}
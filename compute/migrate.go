@@ -0,0 +1,162 @@
+package compute
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// legacyJobTasks maps top-level task attributes of v0.2.x databricks_job
+// to the attributes of corresponding task blocks
+var legacyJobTasks = map[string]map[string]string{
+	"notebook_task": {
+		"notebook_path":            "notebook_path",
+		"notebook_base_parameters": "base_parameters",
+	},
+	"spark_jar_task": {
+		"jar_uri":             "jar_uri",
+		"jar_main_class_name": "main_class_name",
+		"jar_parameters":      "parameters",
+	},
+	"spark_python_task": {
+		"python_file":       "python_file",
+		"python_parameters": "parameters",
+	},
+	"spark_submit_task": {
+		"spark_submit_parameters": "parameters",
+	},
+}
+
+// copySchema returns shallow copy of schema map, so that legacy attributes could be added
+func copySchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	c := make(map[string]*schema.Schema, len(s))
+	for k, v := range s {
+		c[k] = v
+	}
+	return c
+}
+
+// isEmptyState returns true for values, that are left in state by unset attributes
+func isEmptyState(v interface{}) bool {
+	switch x := v.(type) {
+	case nil:
+		return true
+	case string:
+		return x == ""
+	case []interface{}:
+		return len(x) == 0
+	case map[string]interface{}:
+		return len(x) == 0
+	}
+	return false
+}
+
+// JobV2 contains v0.2.x schema of databricks_job with top-level task attributes
+func JobV2() cty.Type {
+	s := copySchema(jobSchema)
+	for _, k := range []string{"notebook_path", "jar_uri", "jar_main_class_name", "python_file"} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+	}
+	for _, k := range []string{"jar_parameters", "python_parameters", "spark_submit_parameters"} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+	s["notebook_base_parameters"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	return (&schema.Resource{Schema: s}).CoreConfigSchema().ImpliedType()
+}
+
+// migrateJobV2 moves top-level task attributes of v0.2.x into task blocks,
+// so that jobs are not recreated after the upgrade
+func migrateJobV2(ctx context.Context, rawState map[string]interface{},
+	meta interface{}) (map[string]interface{}, error) {
+	for block, fields := range legacyJobTasks {
+		task := map[string]interface{}{}
+		for legacy, attr := range fields {
+			v, ok := rawState[legacy]
+			if !ok {
+				continue
+			}
+			delete(rawState, legacy)
+			if isEmptyState(v) {
+				continue
+			}
+			task[attr] = v
+		}
+		if len(task) == 0 || !isEmptyState(rawState[block]) {
+			continue
+		}
+		log.Printf("[INFO] Migrated %s of databricks_job from v0.2.x", block)
+		rawState[block] = []interface{}{task}
+	}
+	return rawState, nil
+}
+
+// InstancePoolV0 contains v0.2.x schema of databricks_instance_pool with disk types
+// directly in disk_spec block and computed default_tags
+func InstancePoolV0(s map[string]*schema.Schema) cty.Type {
+	s = copySchema(s)
+	diskSpec := copySchema(s["disk_spec"].Elem.(*schema.Resource).Schema)
+	for _, k := range []string{"ebs_volume_type", "azure_disk_volume_type"} {
+		diskSpec[k] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+	}
+	s["disk_spec"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     &schema.Resource{Schema: diskSpec},
+	}
+	s["default_tags"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	return (&schema.Resource{Schema: s}).CoreConfigSchema().ImpliedType()
+}
+
+// migrateInstancePoolV0 moves disk types of v0.2.x into disk_type block of disk_spec
+func migrateInstancePoolV0(ctx context.Context, rawState map[string]interface{},
+	meta interface{}) (map[string]interface{}, error) {
+	delete(rawState, "default_tags")
+	diskSpecs, ok := rawState["disk_spec"].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+	for _, raw := range diskSpecs {
+		diskSpec, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		diskType := map[string]interface{}{}
+		for _, k := range []string{"ebs_volume_type", "azure_disk_volume_type"} {
+			v, ok := diskSpec[k]
+			if !ok {
+				continue
+			}
+			delete(diskSpec, k)
+			if !isEmptyState(v) {
+				diskType[k] = v
+			}
+		}
+		if len(diskType) == 0 || !isEmptyState(diskSpec["disk_type"]) {
+			continue
+		}
+		log.Printf("[INFO] Migrated disk_spec of databricks_instance_pool from v0.2.x")
+		diskSpec["disk_type"] = []interface{}{diskType}
+	}
+	return rawState, nil
+}
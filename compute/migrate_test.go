@@ -0,0 +1,121 @@
+package compute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateJobV2(t *testing.T) {
+	state, err := migrateJobV2(context.Background(), map[string]interface{}{
+		"name":                "Legacy",
+		"existing_cluster_id": "abc",
+		"notebook_path":       "/Shared/Demo",
+		"notebook_base_parameters": map[string]interface{}{
+			"env": "dev",
+		},
+		"jar_uri":                 "",
+		"jar_parameters":          []interface{}{},
+		"python_file":             nil,
+		"spark_submit_parameters": nil,
+		"notebook_task":           []interface{}{},
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":                "Legacy",
+		"existing_cluster_id": "abc",
+		"notebook_task": []interface{}{
+			map[string]interface{}{
+				"notebook_path": "/Shared/Demo",
+				"base_parameters": map[string]interface{}{
+					"env": "dev",
+				},
+			},
+		},
+	}, state)
+}
+
+func TestMigrateJobV2_NoLegacyAttributes(t *testing.T) {
+	task := []interface{}{
+		map[string]interface{}{
+			"python_file": "dbfs:/main.py",
+			"parameters":  []interface{}{"a"},
+		},
+	}
+	state, err := migrateJobV2(context.Background(), map[string]interface{}{
+		"name":              "Current",
+		"spark_python_task": task,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":              "Current",
+		"spark_python_task": task,
+	}, state)
+}
+
+func TestJobV2IncludesLegacyAttributes(t *testing.T) {
+	ty := JobV2()
+	for _, attr := range []string{"notebook_path", "notebook_base_parameters", "jar_uri",
+		"jar_main_class_name", "jar_parameters", "python_file", "python_parameters",
+		"spark_submit_parameters", "notebook_task", "new_cluster"} {
+		assert.True(t, ty.HasAttribute(attr), attr)
+	}
+	_, legacy := jobSchema["notebook_path"]
+	assert.False(t, legacy, "current schema must not be modified")
+}
+
+func TestMigrateInstancePoolV0(t *testing.T) {
+	state, err := migrateInstancePoolV0(context.Background(), map[string]interface{}{
+		"instance_pool_name": "Legacy",
+		"default_tags": map[string]interface{}{
+			"Vendor": "Databricks",
+		},
+		"disk_spec": []interface{}{
+			map[string]interface{}{
+				"ebs_volume_type":        "GENERAL_PURPOSE_SSD",
+				"azure_disk_volume_type": "",
+				"disk_count":             1,
+				"disk_size":              32,
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"instance_pool_name": "Legacy",
+		"disk_spec": []interface{}{
+			map[string]interface{}{
+				"disk_type": []interface{}{
+					map[string]interface{}{
+						"ebs_volume_type": "GENERAL_PURPOSE_SSD",
+					},
+				},
+				"disk_count": 1,
+				"disk_size":  32,
+			},
+		},
+	}, state)
+}
+
+func TestMigrateInstancePoolV0_WithoutDiskSpec(t *testing.T) {
+	state, err := migrateInstancePoolV0(context.Background(), map[string]interface{}{
+		"instance_pool_name": "Current",
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"instance_pool_name": "Current",
+	}, state)
+}
+
+func TestInstancePoolV0IncludesLegacyAttributes(t *testing.T) {
+	r := ResourceInstancePool()
+	ty := InstancePoolV0(r.Schema)
+	assert.True(t, ty.HasAttribute("default_tags"))
+	diskSpec := ty.AttributeType("disk_spec").ElementType()
+	assert.True(t, diskSpec.HasAttribute("ebs_volume_type"))
+	assert.True(t, diskSpec.HasAttribute("azure_disk_volume_type"))
+	assert.True(t, diskSpec.HasAttribute("disk_type"))
+	_, legacy := r.Schema["default_tags"]
+	assert.False(t, legacy, "current schema must not be modified")
+}
@@ -3,6 +3,7 @@ package compute
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 )
@@ -148,6 +149,7 @@ type AzureAttributes struct {
 type GcpAttributes struct {
 	UsePreemptibleExecutors bool   `json:"use_preemptible_executors,omitempty" tf:"computed"`
 	GoogleServiceAccount    string `json:"google_service_account,omitempty" tf:"computed"`
+	ZoneID                  string `json:"zone_id,omitempty" tf:"computed"`
 }
 
 // DbfsStorageInfo contains the destination string for DBFS
@@ -250,12 +252,18 @@ type NodeType struct {
 	NodeInstanceType      *NodeInstanceType             `json:"node_instance_type,omitempty"`
 	PhotonWorkerCapable   bool                          `json:"photon_worker_capable,omitempty"`
 	PhotonDriverCapable   bool                          `json:"photon_driver_capable,omitempty"`
+	IsGraviton            bool                          `json:"is_graviton,omitempty"`
+}
+
+// IsFleet returns true for AWS fleet node types, that pick any instance of the given size from the family
+func (nt NodeType) IsFleet() bool {
+	return strings.Contains(nt.NodeTypeID, "-fleet.")
 }
 
 // DockerBasicAuth contains the auth information when fetching containers
 type DockerBasicAuth struct {
 	Username string `json:"username"`
-	Password string `json:"password"`
+	Password string `json:"password" tf:"sensitive"`
 }
 
 // DockerImage contains the image url and the auth for DCS
@@ -366,6 +374,12 @@ type ClusterPolicy struct {
 	CreatedAtTimeStamp int64  `json:"created_at_timestamp"`
 }
 
+// ClusterPolicyList is the response of cluster policies listing
+type ClusterPolicyList struct {
+	Policies   []ClusterPolicy `json:"policies,omitempty"`
+	TotalCount int32           `json:"total_count,omitempty"`
+}
+
 // ClusterPolicyCreate is the endity used for request
 type ClusterPolicyCreate struct {
 	Name       string `json:"name"`
@@ -413,14 +427,14 @@ type InstancePool struct {
 	MinIdleInstances                   int32                        `json:"min_idle_instances,omitempty"`
 	MaxCapacity                        int32                        `json:"max_capacity,omitempty"`
 	IdleInstanceAutoTerminationMinutes int32                        `json:"idle_instance_autotermination_minutes"`
-	AwsAttributes                      *InstancePoolAwsAttributes   `json:"aws_attributes,omitempty"`
-	AzureAttributes                    *InstancePoolAzureAttributes `json:"azure_attributes,omitempty"`
-	NodeTypeID                         string                       `json:"node_type_id"`
-	CustomTags                         map[string]string            `json:"custom_tags,omitempty"`
-	EnableElasticDisk                  bool                         `json:"enable_elastic_disk,omitempty"`
-	DiskSpec                           *InstancePoolDiskSpec        `json:"disk_spec,omitempty"`
-	PreloadedSparkVersions             []string                     `json:"preloaded_spark_versions,omitempty"`
-	PreloadedDockerImages              []DockerImage                `json:"preloaded_docker_images,omitempty" tf:"slice_set,alias:preloaded_docker_image"`
+	AwsAttributes                      *InstancePoolAwsAttributes   `json:"aws_attributes,omitempty" tf:"force_new,suppress_diff"`
+	AzureAttributes                    *InstancePoolAzureAttributes `json:"azure_attributes,omitempty" tf:"force_new,suppress_diff"`
+	NodeTypeID                         string                       `json:"node_type_id" tf:"force_new"`
+	CustomTags                         map[string]string            `json:"custom_tags,omitempty" tf:"force_new"`
+	EnableElasticDisk                  bool                         `json:"enable_elastic_disk,omitempty" tf:"force_new"`
+	DiskSpec                           *InstancePoolDiskSpec        `json:"disk_spec,omitempty" tf:"force_new"`
+	PreloadedSparkVersions             []string                     `json:"preloaded_spark_versions,omitempty" tf:"force_new"`
+	PreloadedDockerImages              []DockerImage                `json:"preloaded_docker_images,omitempty" tf:"slice_set,alias:preloaded_docker_image,force_new"`
 }
 
 // InstancePoolStats contains the stats on a given pool
@@ -489,7 +503,11 @@ func (l *NodeTypeList) Sort() {
 		if l.NodeTypes[i].NumGPUs != l.NodeTypes[j].NumGPUs {
 			return l.NodeTypes[i].NumGPUs < l.NodeTypes[j].NumGPUs
 		}
-		return l.NodeTypes[i].InstanceTypeID < l.NodeTypes[j].InstanceTypeID
+		if l.NodeTypes[i].InstanceTypeID != l.NodeTypes[j].InstanceTypeID {
+			return l.NodeTypes[i].InstanceTypeID < l.NodeTypes[j].InstanceTypeID
+		}
+		// node types may share instance type, e.g. fleets, so make the order stable
+		return l.NodeTypes[i].NodeTypeID < l.NodeTypes[j].NodeTypeID
 	})
 }
 
@@ -600,6 +618,7 @@ type JobRun struct {
 	State       RunState `json:"state"`
 	Trigger     string   `json:"trigger,omitempty"`
 	RuntType    string   `json:"run_type,omitempty"`
+	RunPageURL  string   `json:"run_page_url,omitempty"`
 
 	OverridingParameters RunParameters `json:"overriding_parameters,omitempty"`
 }
@@ -758,6 +777,7 @@ type SparkVersionRequest struct {
 	ML              bool   `json:"ml,omitempty" tf:"optional,default:false"`
 	Genomics        bool   `json:"genomics,omitempty" tf:"optional,default:false"`
 	GPU             bool   `json:"gpu,omitempty" tf:"optional,default:false"`
+	Photon          bool   `json:"photon,omitempty" tf:"optional,default:false"`
 	Scala           string `json:"scala,omitempty" tf:"optional,default:2.12"`
 	SparkVersion    string `json:"spark_version,omitempty" tf:"optional,default:"`
 }
@@ -0,0 +1,183 @@
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// policyElement is a single rule of cluster policy definition
+// https://docs.databricks.com/administration-guide/clusters/policies.html#policy-elements
+type policyElement struct {
+	Type     string        `json:"type"`
+	Value    interface{}   `json:"value,omitempty"`
+	Values   []interface{} `json:"values,omitempty"`
+	Pattern  string        `json:"pattern,omitempty"`
+	MinValue *float64      `json:"minValue,omitempty"`
+	MaxValue *float64      `json:"maxValue,omitempty"`
+}
+
+// policyPathToAttribute translates path of policy element, like `autoscale.max_workers` or
+// `spark_conf.spark.databricks.cluster.profile`, into attribute of Terraform schema and
+// optional key within map attribute. Virtual attributes, like `dbus_per_hour`, and elements
+// with wildcards are not resolved.
+func policyPathToAttribute(s map[string]*schema.Schema, path string) (attr, key string, ok bool) {
+	parts := strings.Split(path, ".")
+	tfPath := []string{}
+	for i := 0; i < len(parts); i++ {
+		fs, found := s[parts[i]]
+		if !found {
+			return "", "", false
+		}
+		tfPath = append(tfPath, parts[i])
+		last := i == len(parts)-1
+		switch fs.Type {
+		case schema.TypeMap:
+			if last {
+				return "", "", false
+			}
+			return strings.Join(tfPath, "."), strings.Join(parts[i+1:], "."), true
+		case schema.TypeList:
+			r, isBlock := fs.Elem.(*schema.Resource)
+			if !isBlock || last {
+				return "", "", false
+			}
+			idx := "0"
+			if _, err := strconv.Atoi(parts[i+1]); err == nil {
+				idx = parts[i+1]
+				i++
+			}
+			tfPath = append(tfPath, idx)
+			s = r.Schema
+		case schema.TypeSet:
+			return "", "", false
+		default:
+			if !last {
+				return "", "", false
+			}
+			return strings.Join(tfPath, "."), "", true
+		}
+	}
+	return "", "", false
+}
+
+// configuredPolicyValue returns value of attribute only if it's known at plan time and
+// not empty, as the rest of the attributes are going to be filled by the policy itself
+func configuredPolicyValue(d *schema.ResourceDiff, attr, key string) (interface{}, bool) {
+	if !d.NewValueKnown(attr) {
+		return nil, false
+	}
+	v, ok := d.GetOk(attr)
+	if !ok {
+		return nil, false
+	}
+	if key == "" {
+		return v, true
+	}
+	m, isMap := v.(map[string]interface{})
+	if !isMap {
+		return nil, false
+	}
+	v, ok = m[key]
+	return v, ok
+}
+
+func (pe policyElement) validate(path string, v interface{}) error {
+	actual := fmt.Sprint(v)
+	switch pe.Type {
+	case "fixed":
+		if actual != fmt.Sprint(pe.Value) {
+			return fmt.Errorf("%s must be %v, but is %s", path, pe.Value, actual)
+		}
+	case "forbidden":
+		return fmt.Errorf("%s is forbidden, but is %s", path, actual)
+	case "allowlist":
+		for _, allowed := range pe.Values {
+			if actual == fmt.Sprint(allowed) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s must be one of %v, but is %s", path, pe.Values, actual)
+	case "blocklist":
+		for _, blocked := range pe.Values {
+			if actual == fmt.Sprint(blocked) {
+				return fmt.Errorf("%s must not be one of %v, but is %s", path, pe.Values, actual)
+			}
+		}
+	case "regex":
+		re, err := regexp.Compile(pe.Pattern)
+		if err != nil {
+			return fmt.Errorf("%s has invalid pattern in policy: %w", path, err)
+		}
+		if !re.MatchString(actual) {
+			return fmt.Errorf("%s must match %s, but is %s", path, pe.Pattern, actual)
+		}
+	case "range":
+		number, err := strconv.ParseFloat(actual, 64)
+		if err != nil {
+			return fmt.Errorf("%s must be a number, but is %s", path, actual)
+		}
+		if pe.MinValue != nil && number < *pe.MinValue {
+			return fmt.Errorf("%s must be at least %v, but is %s", path, *pe.MinValue, actual)
+		}
+		if pe.MaxValue != nil && number > *pe.MaxValue {
+			return fmt.Errorf("%s must be at most %v, but is %s", path, *pe.MaxValue, actual)
+		}
+	}
+	return nil
+}
+
+// validateClusterPolicy evaluates cluster specification at prefix, like `new_cluster.0.`,
+// against policy definition and returns all violations at once
+func validateClusterPolicy(d *schema.ResourceDiff, s map[string]*schema.Schema,
+	prefix, policyID, definition string) error {
+	var elements map[string]policyElement
+	if err := json.Unmarshal([]byte(definition), &elements); err != nil {
+		return fmt.Errorf("cannot parse definition of policy %s: %w", policyID, err)
+	}
+	violations := []string{}
+	for path, element := range elements {
+		attr, key, ok := policyPathToAttribute(s, path)
+		if !ok {
+			continue
+		}
+		v, ok := configuredPolicyValue(d, prefix+attr, key)
+		if !ok {
+			continue
+		}
+		if err := element.validate(path, v); err != nil {
+			violations = append(violations, err.Error())
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.Strings(violations)
+	return fmt.Errorf("cluster doesn't conform to policy %s: %s",
+		policyID, strings.Join(violations, "; "))
+}
+
+// customizeDiffWithPolicy fetches policy of the cluster specification at prefix and validates
+// the specification against it, so that violations are reported before any API mutation
+func customizeDiffWithPolicy(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient,
+	s map[string]*schema.Schema, prefix string) error {
+	if !d.NewValueKnown(prefix + "policy_id") {
+		return nil
+	}
+	policyID := d.Get(prefix + "policy_id").(string)
+	if policyID == "" {
+		return nil
+	}
+	policy, err := NewClusterPoliciesAPI(ctx, c).Get(policyID)
+	if err != nil {
+		return err
+	}
+	return validateClusterPolicy(d, s, prefix, policyID, policy.Definition)
+}
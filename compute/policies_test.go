@@ -0,0 +1,124 @@
+package compute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var teamPolicyFixture = qa.HTTPFixture{
+	Method:       "GET",
+	Resource:     "/api/2.0/policies/clusters/get?policy_id=abc",
+	ReuseRequest: true,
+	Response: ClusterPolicy{
+		PolicyID: "abc",
+		Name:     "Team",
+		Definition: `{
+			"spark_version": {"type": "regex", "pattern": "^7\\..*"},
+			"node_type_id": {"type": "allowlist", "values": ["i3.xlarge", "i3.2xlarge"]},
+			"autotermination_minutes": {"type": "range", "minValue": 10, "maxValue": 60},
+			"autoscale.max_workers": {"type": "range", "maxValue": 10},
+			"aws_attributes.availability": {"type": "fixed", "value": "SPOT"},
+			"spark_conf.spark.databricks.io.cache.enabled": {"type": "fixed", "value": "true"},
+			"custom_tags.team": {"type": "blocklist", "values": ["nobody"]},
+			"instance_pool_id": {"type": "forbidden"},
+			"dbus_per_hour": {"type": "range", "maxValue": 10},
+			"init_scripts.*.dbfs.destination": {"type": "fixed", "value": "dbfs:/a.sh"}
+		}`,
+	},
+}
+
+func TestPolicyPathToAttribute(t *testing.T) {
+	for path, expected := range map[string][]string{
+		"spark_version":                   {"spark_version", ""},
+		"autoscale.max_workers":           {"autoscale.0.max_workers", ""},
+		"aws_attributes.availability":     {"aws_attributes.0.availability", ""},
+		"spark_conf.spark.foo.bar":        {"spark_conf", "spark.foo.bar"},
+		"init_scripts.1.dbfs.destination": {"init_scripts.1.dbfs.0.destination", ""},
+	} {
+		attr, key, ok := policyPathToAttribute(clusterSchema, path)
+		assert.True(t, ok, path)
+		assert.Equal(t, expected, []string{attr, key}, path)
+	}
+	for _, path := range []string{"dbus_per_hour", "spark_conf", "autoscale",
+		"init_scripts.*.dbfs.destination", "spark_version.foo"} {
+		_, _, ok := policyPathToAttribute(clusterSchema, path)
+		assert.False(t, ok, path)
+	}
+}
+
+func TestResourceClusterCreate_PolicyViolations(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{teamPolicyFixture},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `policy_id = "abc"
+		spark_version = "8.1-scala12"
+		node_type_id = "m5.large"
+		autotermination_minutes = 120
+		autoscale {
+			min_workers = 1
+			max_workers = 20
+		}
+		aws_attributes {
+			availability = "ON_DEMAND"
+		}
+		spark_conf = {
+			"spark.databricks.io.cache.enabled" = "false"
+		}
+		custom_tags = {
+			"team" = "nobody"
+		}`,
+	}.ExpectError(t, "cluster doesn't conform to policy abc: "+
+		"autoscale.max_workers must be at most 10, but is 20; "+
+		"autotermination_minutes must be at most 60, but is 120; "+
+		"aws_attributes.availability must be SPOT, but is ON_DEMAND; "+
+		"custom_tags.team must not be one of [nobody], but is nobody; "+
+		"node_type_id must be one of [i3.xlarge i3.2xlarge], but is m5.large; "+
+		"spark_conf.spark.databricks.io.cache.enabled must be true, but is false; "+
+		"spark_version must match ^7\\..*, but is 8.1-scala12")
+}
+
+func TestResourceClusterDiff_ConformsToPolicy(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{teamPolicyFixture},
+		func(ctx context.Context, client *common.DatabricksClient) {
+			diff, err := ResourceCluster().Diff(ctx, &terraform.InstanceState{},
+				terraform.NewResourceConfigRaw(map[string]interface{}{
+					"policy_id":               "abc",
+					"spark_version":           "7.3-scala12",
+					"node_type_id":            "i3.xlarge",
+					"autotermination_minutes": 30,
+					"num_workers":             2,
+					"aws_attributes": []interface{}{
+						map[string]interface{}{
+							"availability": "SPOT",
+						},
+					},
+				}), client)
+			require.NoError(t, err)
+			assert.NotNil(t, diff)
+		})
+}
+
+func TestResourceJobCreate_PolicyViolations(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{teamPolicyFixture},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `new_cluster {
+			policy_id = "abc"
+			spark_version = "7.3-scala12"
+			instance_pool_id = "pool"
+			num_workers = 1
+		}
+		notebook_task {
+			notebook_path = "/Stuff"
+		}`,
+	}.ExpectError(t, "cluster doesn't conform to policy abc: "+
+		"instance_pool_id is forbidden, but is pool")
+}
@@ -2,8 +2,11 @@ package compute
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,6 +33,9 @@ func ResourceCluster() *schema.Resource {
 			d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewClustersAPI(ctx, c).PermanentDelete(d.Id())
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			return customizeDiffWithPolicy(ctx, d, c, clusterSchema, "")
+		},
 		Schema:        clusterSchema,
 		SchemaVersion: 2,
 		Timeouts: &schema.ResourceTimeout{
@@ -50,19 +56,168 @@ func sparkConfDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 	return false
 }
 
+// serverDefaultSparkConf are entries, that clusters API adds to spark_conf on its own
+var serverDefaultSparkConf = map[string]bool{
+	"spark.databricks.delta.preview.enabled": true,
+}
+
+// serverDefaultSparkEnvVars are entries, that clusters API adds to spark_env_vars on its own
+var serverDefaultSparkEnvVars = map[string]bool{
+	"PYSPARK_PYTHON": true,
+}
+
+// makeServerDefaultsSuppressFunc suppresses removal of map entries, that were not
+// configured, but were added by the server, including the change of map size
+func makeServerDefaultsSuppressFunc(name string, defaults map[string]bool) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if k == name+".%" {
+			o, _ := d.GetChange(name)
+			oldMap, _ := o.(map[string]interface{})
+			added := 0
+			for key := range oldMap {
+				if defaults[key] {
+					added++
+				}
+			}
+			oldLen, _ := strconv.Atoi(old)
+			newLen, _ := strconv.Atoi(new)
+			if added > 0 && oldLen-added == newLen {
+				log.Printf("[DEBUG] Suppressing diff for k=%#v old=%#v new=%#v", k, old, new)
+				return true
+			}
+			return false
+		}
+		if new == "" && defaults[strings.TrimPrefix(k, name+".")] {
+			log.Printf("[DEBUG] Suppressing diff for k=%#v old=%#v new=%#v", k, old, new)
+			return true
+		}
+		return false
+	}
+}
+
+// secretReferenceRegex matches secret references, like `{{secrets/scope/key}}`, allowing
+// the whitespace inside of curly braces, that API strips on its own
+var secretReferenceRegex = regexp.MustCompile(`\{\{\s*secrets/([^/\s{}]+)/([^/\s{}]+)\s*\}\}`)
+
+// normalizeSecretReferences rewrites secret references within value into the form,
+// that is returned by the API
+func normalizeSecretReferences(value string) string {
+	return secretReferenceRegex.ReplaceAllString(value, "{{secrets/$1/$2}}")
+}
+
+// withSecretReferences extends diff suppression of spark_conf and spark_env_vars with
+// the suppression of values, that differ only in formatting of secret references
+func withSecretReferences(suppress schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if old != "" && new != "" && old != new &&
+			normalizeSecretReferences(old) == normalizeSecretReferences(new) {
+			log.Printf("[DEBUG] Suppressing diff for k=%#v old=%#v new=%#v", k, old, new)
+			return true
+		}
+		if suppress == nil {
+			return false
+		}
+		return suppress(k, old, new, d)
+	}
+}
+
+// validateSecretReferences fails on map values, that look like secret references, but
+// do not follow `{{secrets/scope/key}}` syntax
+func validateSecretReferences(i interface{}, k string) (_ []string, es []error) {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for key, raw := range m {
+		v, ok := raw.(string)
+		if !ok || !strings.Contains(v, "{{") {
+			continue
+		}
+		rest := secretReferenceRegex.ReplaceAllString(v, "")
+		if strings.Contains(rest, "secrets") {
+			es = append(es, fmt.Errorf("%s.%s has invalid secret reference: %s. "+
+				"Expected format is {{secrets/<scope>/<key>}}", k, key, v))
+		}
+	}
+	return
+}
+
+// addSecretReferenceHandling adds validation and diff suppression of secret references
+// to spark_conf and spark_env_vars of a cluster specification schema
+func addSecretReferenceHandling(s map[string]*schema.Schema) {
+	for _, field := range []string{"spark_conf", "spark_env_vars"} {
+		v, ok := s[field]
+		if !ok {
+			continue
+		}
+		v.DiffSuppressFunc = withSecretReferences(v.DiffSuppressFunc)
+		v.ValidateFunc = validateSecretReferences
+	}
+}
+
+// zoneIDDiffSuppressFunc suppresses the diff between `auto` zone and the one,
+// that was picked by the server
+func zoneIDDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old != "" && strings.EqualFold(new, "auto") {
+		log.Printf("[DEBUG] Suppressing diff for k=%#v old=%#v new=%#v", k, old, new)
+		return true
+	}
+	return false
+}
+
+// removePolicyDefaults removes spark_conf and custom_tags entries, that were added to
+// the cluster by fixed elements of its policy and were never part of the configuration
+func removePolicyDefaults(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient,
+	clusterInfo *ClusterInfo) error {
+	if clusterInfo.PolicyID == "" {
+		return nil
+	}
+	policy, err := NewClusterPoliciesAPI(ctx, c).Get(clusterInfo.PolicyID)
+	if e, ok := err.(common.APIError); ok && e.IsMissing() {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var definition map[string]struct {
+		Type string `json:"type"`
+	}
+	if err = json.Unmarshal([]byte(policy.Definition), &definition); err != nil {
+		return fmt.Errorf("cannot parse definition of policy %s: %w", clusterInfo.PolicyID, err)
+	}
+	for path, element := range definition {
+		if element.Type != "fixed" {
+			continue
+		}
+		for name, values := range map[string]map[string]string{
+			"spark_conf":  clusterInfo.SparkConf,
+			"custom_tags": clusterInfo.CustomTags,
+		} {
+			if !strings.HasPrefix(path, name+".") {
+				continue
+			}
+			key := strings.TrimPrefix(path, name+".")
+			configured, _ := d.Get(name).(map[string]interface{})
+			if _, ok := configured[key]; !ok {
+				delete(values, key)
+			}
+		}
+	}
+	return nil
+}
+
 func resourceClusterSchema() map[string]*schema.Schema {
 	return common.StructToSchema(Cluster{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
-		s["spark_conf"].DiffSuppressFunc = sparkConfDiffSuppressFunc
+		s["spark_conf"].DiffSuppressFunc = makeServerDefaultsSuppressFunc("spark_conf", serverDefaultSparkConf)
+		s["spark_env_vars"].DiffSuppressFunc = makeServerDefaultsSuppressFunc("spark_env_vars", serverDefaultSparkEnvVars)
+		addSecretReferenceHandling(s)
 		// adds `libraries` configuration block
 		s["library"] = common.StructToSchema(ClusterLibraryList{},
 			func(ss map[string]*schema.Schema) map[string]*schema.Schema {
 				return ss
 			})["library"]
+		addLibrarySchemaValidation(s)
 
-		p, err := common.SchemaPath(s, "docker_image", "basic_auth", "password")
-		if err == nil {
-			p.Sensitive = true
-		}
 		s["autotermination_minutes"].Default = 60
 		s["idempotency_token"].ForceNew = true
 		s["cluster_id"] = &schema.Schema{
@@ -71,11 +226,30 @@ func resourceClusterSchema() map[string]*schema.Schema {
 			Computed: true,
 		}
 		s["aws_attributes"].ConflictsWith = []string{"azure_attributes", "gcp_attributes"}
-		s["azure_attributes"].ConflictsWith = []string{"aws_attributes", "gcp_attributes"}
+		s["azure_attributes"].ConflictsWith = []string{"aws_attributes", "gcp_attributes", "instance_pool_id"}
 		s["gcp_attributes"].ConflictsWith = []string{"aws_attributes", "azure_attributes"}
+		// nodes of instance pool are already provisioned, so only identity could be given
+		// to them. Other attributes would be silently dropped by modifyClusterRequest
+		for block, allowed := range map[string]string{
+			"aws_attributes": "instance_profile_arn",
+			"gcp_attributes": "google_service_account",
+		} {
+			attrs := s[block].Elem.(*schema.Resource).Schema
+			for field, fs := range attrs {
+				if field == allowed {
+					continue
+				}
+				fs.ConflictsWith = []string{"instance_pool_id"}
+			}
+		}
 		s["aws_attributes"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("aws_attributes.#")
 		s["azure_attributes"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("azure_attributes.#")
 		s["gcp_attributes"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("gcp_attributes.#")
+		for _, block := range []string{"aws_attributes", "gcp_attributes"} {
+			if p, err := common.SchemaPath(s, block, "zone_id"); err == nil {
+				p.DiffSuppressFunc = zoneIDDiffSuppressFunc
+			}
+		}
 
 		s["instance_pool_id"].ConflictsWith = []string{"driver_node_type_id", "node_type_id"}
 		s["driver_instance_pool_id"].ConflictsWith = []string{"driver_node_type_id", "node_type_id"}
@@ -106,7 +280,9 @@ func resourceClusterSchema() map[string]*schema.Schema {
 			Optional:         true,
 			Default:          0,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			ConflictsWith:    []string{"autoscale"},
 		}
+		s["autoscale"].ConflictsWith = []string{"num_workers"}
 		s["url"] = &schema.Schema{
 			Type:     schema.TypeString,
 			Computed: true,
@@ -161,7 +337,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 		if err = librariesAPI.Install(libraryList); err != nil {
 			return err
 		}
-		if _, err := waitForLibrariesInstalled(librariesAPI, clusterInfo); err != nil {
+		if _, err := waitForLibrariesInstalled(librariesAPI, clusterInfo, false); err != nil {
 			return err
 		}
 	}
@@ -192,6 +368,9 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, c *common.
 	if err != nil {
 		return err
 	}
+	if err = removePolicyDefaults(ctx, d, c, &clusterInfo); err != nil {
+		return err
+	}
 	if err = common.StructToData(clusterInfo, clusterSchema, d); err != nil {
 		return err
 	}
@@ -200,17 +379,26 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, c *common.
 	}
 	d.Set("url", c.FormatURL("#setting/clusters/", d.Id(), "/configuration"))
 	librariesAPI := NewLibrariesAPI(ctx, c)
-	libsClusterStatus, err := waitForLibrariesInstalled(librariesAPI, clusterInfo)
+	// failed libraries don't fail the refresh, but are missing from the state, so that
+	// they are installed again on the next apply
+	libsClusterStatus, err := waitForLibrariesInstalled(librariesAPI, clusterInfo, true)
 	if err != nil {
 		return err
 	}
 	libList := libsClusterStatus.ToLibraryList()
+	if len(libList.Libraries) == 0 {
+		// empty list is skipped by StructToData, but libraries missing on the cluster, e.g.
+		// when it was re-created outside of terraform, have to be installed again
+		return d.Set("library", nil)
+	}
 	return common.StructToData(libList, clusterSchema, d)
 }
 
-func waitForLibrariesInstalled(
-	libraries LibrariesAPI, clusterInfo ClusterInfo) (result *ClusterLibraryStatuses, err error) {
-	err = resource.RetryContext(libraries.context, 30*time.Minute, func() *resource.RetryError {
+// waitForLibrariesInstalled waits for pending libraries. Failed libraries result in error,
+// unless ignoreFailed is set.
+func waitForLibrariesInstalled(libraries LibrariesAPI, clusterInfo ClusterInfo,
+	ignoreFailed bool) (result *ClusterLibraryStatuses, err error) {
+	err = resource.RetryContext(libraries.context, common.TimeoutFromContext(libraries.context, 30*time.Minute), func() *resource.RetryError {
 		libsClusterStatus, err := libraries.ClusterStatus(clusterInfo.ClusterID)
 		if ae, ok := err.(common.APIError); ok && ae.IsMissing() {
 			// eventual consistency error
@@ -229,7 +417,9 @@ func waitForLibrariesInstalled(
 		if retry {
 			return resource.RetryableError(err)
 		}
-		if err != nil {
+		if err != nil && ignoreFailed {
+			log.Printf("[WARN] %s", err)
+		} else if err != nil {
 			return resource.NonRetryableError(err)
 		}
 		result = &libsClusterStatus
@@ -363,6 +553,6 @@ func updateLibraries(libraries LibrariesAPI, clusterInfo ClusterInfo,
 			return err
 		}
 	}
-	_, err := waitForLibrariesInstalled(libraries, clusterInfo)
+	_, err := waitForLibrariesInstalled(libraries, clusterInfo, false)
 	return err
 }
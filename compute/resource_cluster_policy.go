@@ -50,6 +50,13 @@ func (a ClusterPoliciesAPI) Get(policyID string) (policy ClusterPolicy, err erro
 	return
 }
 
+// List returns all cluster policies, that are visible to the caller
+func (a ClusterPoliciesAPI) List() ([]ClusterPolicy, error) {
+	var policyList ClusterPolicyList
+	err := a.client.Get(a.context, "/policies/clusters/list", nil, &policyList)
+	return policyList.Policies, err
+}
+
 // Delete removes cluster policy
 func (a ClusterPoliciesAPI) Delete(policyID string) error {
 	return a.client.Post(a.context, "/policies/clusters/delete", policyIDWrapper{policyID}, nil)
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -8,6 +9,8 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -214,13 +217,11 @@ func TestResourceClusterCreate_WithLibraries(t *testing.T) {
 					ClusterID: "abc",
 					Libraries: []Library{
 						{
-							Pypi: &PyPi{
-								Package: "seaborn==1.2.4",
+							Cran: &Cran{
+								Package: "rkeops",
+								Repo:    "internal",
 							},
 						},
-						{
-							Whl: "dbfs://baz.whl",
-						},
 						{
 							Maven: &Maven{
 								Coordinates: "foo:bar:baz:0.1.0",
@@ -229,15 +230,17 @@ func TestResourceClusterCreate_WithLibraries(t *testing.T) {
 							},
 						},
 						{
-							Egg: "dbfs://bar.egg",
+							Jar: "dbfs://foo.jar",
 						},
 						{
-							Jar: "dbfs://foo.jar",
+							Whl: "dbfs://baz.whl",
 						},
 						{
-							Cran: &Cran{
-								Package: "rkeops",
-								Repo:    "internal",
+							Egg: "dbfs://bar.egg",
+						},
+						{
+							Pypi: &PyPi{
+								Package: "seaborn==1.2.4",
 							},
 						},
 					},
@@ -446,7 +449,7 @@ func TestResourceClusterRead(t *testing.T) {
 	assert.Equal(t, "Shared Autoscaling", d.Get("cluster_name"))
 	assert.Equal(t, "i3.xlarge", d.Get("node_type_id"))
 	assert.Equal(t, 4, d.Get("autoscale.0.max_workers"))
-	assert.Equal(t, "requests", d.Get("library.754562683.pypi.0.package"))
+	assert.Equal(t, "requests", d.Get("library.2772500810.pypi.0.package"))
 	assert.Equal(t, "RUNNING", d.Get("state"))
 	assert.Equal(t, false, d.Get("is_pinned"))
 
@@ -1106,3 +1109,378 @@ func TestModifyClusterRequestGcp(t *testing.T) {
 	assert.Equal(t, "", c.DriverNodeTypeID)
 	assert.Equal(t, false, c.EnableElasticDisk)
 }
+
+func clusterDiffState() *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: "abc",
+		Attributes: map[string]string{
+			"id":                       "abc",
+			"cluster_id":               "abc",
+			"cluster_name":             "Shared",
+			"spark_version":            "7.1-scala12",
+			"node_type_id":             "i3.xlarge",
+			"driver_node_type_id":      "i3.xlarge",
+			"num_workers":              "1",
+			"autotermination_minutes":  "60",
+			"enable_elastic_disk":      "true",
+			"is_pinned":                "false",
+			"aws_attributes.#":         "1",
+			"aws_attributes.0.zone_id": "us-west-2a",
+			"spark_conf.%":             "2",
+			"spark_conf.spark.foo":     "bar",
+			"spark_conf.spark.databricks.delta.preview.enabled": "true",
+			"spark_env_vars.%":              "1",
+			"spark_env_vars.PYSPARK_PYTHON": "/databricks/python3/bin/python3",
+		},
+	}
+}
+
+func TestResourceClusterDiff_ServerDefaultsSuppressed(t *testing.T) {
+	diff, err := ResourceCluster().Diff(context.Background(), clusterDiffState(),
+		terraform.NewResourceConfigRaw(map[string]interface{}{
+			"cluster_name":  "Shared",
+			"spark_version": "7.1-scala12",
+			"node_type_id":  "i3.xlarge",
+			"num_workers":   1,
+			"aws_attributes": []interface{}{
+				map[string]interface{}{
+					"zone_id": "auto",
+				},
+			},
+			"spark_conf": map[string]interface{}{
+				"spark.foo": "bar",
+			},
+		}), nil)
+	require.NoError(t, err)
+	for k := range diff.Attributes {
+		assert.True(t, strings.HasPrefix(k, "default_tags"), "unexpected diff in %s", k)
+	}
+}
+
+func TestResourceClusterDiff_ConfiguredChangesAreNotSuppressed(t *testing.T) {
+	diff, err := ResourceCluster().Diff(context.Background(), clusterDiffState(),
+		terraform.NewResourceConfigRaw(map[string]interface{}{
+			"cluster_name":  "Shared",
+			"spark_version": "7.1-scala12",
+			"node_type_id":  "i3.xlarge",
+			"num_workers":   1,
+			"aws_attributes": []interface{}{
+				map[string]interface{}{
+					"zone_id": "us-west-2b",
+				},
+			},
+			"spark_conf": map[string]interface{}{},
+		}), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.Equal(t, "us-west-2b", diff.Attributes["aws_attributes.0.zone_id"].New)
+	assert.True(t, diff.Attributes["spark_conf.spark.foo"].NewRemoved)
+	assert.Nil(t, diff.Attributes["spark_conf.spark.databricks.delta.preview.enabled"])
+}
+
+func TestResourceClusterRead_RemovesPolicyDefaults(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Shared",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateRunning,
+					PolicyID:               "def",
+					SparkConf: map[string]string{
+						"spark.foo":    "bar",
+						"spark.policy": "fixed",
+					},
+					CustomTags: map[string]string{
+						"team": "fixed",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=def",
+				Response: ClusterPolicy{
+					PolicyID: "def",
+					Name:     "Team",
+					Definition: `{
+						"spark_conf.spark.policy": {"type": "fixed", "value": "fixed"},
+						"spark_conf.spark.foo": {"type": "fixed", "value": "bar"},
+						"custom_tags.team": {"type": "fixed", "value": "fixed"},
+						"autotermination_minutes": {"type": "range", "maxValue": 30}
+					}`,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{},
+			},
+		},
+		Resource: ResourceCluster(),
+		State: map[string]interface{}{
+			"cluster_name":  "Shared",
+			"spark_version": "7.1-scala12",
+			"node_type_id":  "i3.xlarge",
+			"num_workers":   1,
+			"spark_conf": map[string]interface{}{
+				"spark.foo": "bar",
+			},
+		},
+		Read: true,
+		ID:   "abc",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, map[string]interface{}{"spark.foo": "bar"}, d.Get("spark_conf"))
+	assert.Equal(t, map[string]interface{}{}, d.Get("custom_tags"))
+}
+
+func TestResourceClusterCreate_NumWorkersConflictsWithAutoscale(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `num_workers = 2
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		autoscale {
+			min_workers = 1
+			max_workers = 4
+		}`,
+	}.Apply(t)
+	assert.EqualError(t, err, "invalid config supplied. "+
+		"[autoscale] Conflicting configuration arguments. "+
+		"[num_workers] Conflicting configuration arguments")
+}
+
+func TestResourceClusterCreate_InstancePoolConflicts(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `num_workers = 2
+		spark_version = "7.1-scala12"
+		instance_pool_id = "abc"
+		node_type_id = "i3.xlarge"
+		aws_attributes {
+			availability = "SPOT"
+		}`,
+	}.Apply(t)
+	qa.AssertErrorStartsWith(t, err, "invalid config supplied.")
+	assert.Contains(t, err.Error(), "[aws_attributes.#.availability] Conflicting configuration arguments")
+	assert.Contains(t, err.Error(), "[node_type_id] Conflicting configuration arguments")
+}
+
+func TestResourceClusterValidate_InstancePoolWithInstanceProfile(t *testing.T) {
+	diags := ResourceCluster().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"num_workers":      2,
+		"spark_version":    "7.1-scala12",
+		"instance_pool_id": "abc",
+		"aws_attributes": []interface{}{
+			map[string]interface{}{
+				"instance_profile_arn": "arn:aws:iam::123:instance-profile/x",
+			},
+		},
+	}))
+	assert.False(t, diags.HasError(), "%v", diags)
+}
+
+func TestNormalizeSecretReferences(t *testing.T) {
+	assert.Equal(t, "{{secrets/scope/key}}", normalizeSecretReferences("{{ secrets/scope/key }}"))
+	assert.Equal(t, "a {{secrets/s/k}} b", normalizeSecretReferences("a {{secrets/s/k  }} b"))
+	assert.Equal(t, "plain", normalizeSecretReferences("plain"))
+}
+
+func TestValidateSecretReferences(t *testing.T) {
+	_, es := validateSecretReferences(map[string]interface{}{
+		"spark.a": "{{secrets/scope/key}}",
+		"spark.b": "{{ secrets/scope/key }}",
+		"spark.c": "{{not-a-secret}}",
+	}, "spark_conf")
+	assert.Len(t, es, 0)
+
+	_, es = validateSecretReferences(map[string]interface{}{
+		"spark.password": "{{secrets/scope}}",
+	}, "spark_conf")
+	require.Len(t, es, 1)
+	assert.EqualError(t, es[0], "spark_conf.spark.password has invalid secret reference: "+
+		"{{secrets/scope}}. Expected format is {{secrets/<scope>/<key>}}")
+}
+
+func TestResourceClusterDiff_SecretReferencesSuppressed(t *testing.T) {
+	state := clusterDiffState()
+	state.Attributes["spark_conf.%"] = "3"
+	state.Attributes["spark_conf.spark.password"] = "{{secrets/scope/key}}"
+	diff, err := ResourceCluster().Diff(context.Background(), state,
+		terraform.NewResourceConfigRaw(map[string]interface{}{
+			"cluster_name":  "Shared",
+			"spark_version": "7.1-scala12",
+			"node_type_id":  "i3.xlarge",
+			"num_workers":   1,
+			"aws_attributes": []interface{}{
+				map[string]interface{}{
+					"zone_id": "us-west-2a",
+				},
+			},
+			"spark_conf": map[string]interface{}{
+				"spark.foo":      "bar",
+				"spark.password": "{{ secrets/scope/key }}",
+			},
+		}), nil)
+	require.NoError(t, err)
+	for k := range diff.Attributes {
+		assert.True(t, strings.HasPrefix(k, "default_tags"), "unexpected diff in %s", k)
+	}
+}
+
+func TestResourceClusterValidate_InvalidSecretReference(t *testing.T) {
+	diags := ResourceCluster().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"num_workers":   2,
+		"spark_version": "7.1-scala12",
+		"node_type_id":  "i3.xlarge",
+		"spark_env_vars": map[string]interface{}{
+			"PASSWORD": "{{secrets/scope/}}",
+		},
+	}))
+	assert.True(t, diags.HasError())
+}
+
+func TestResourceClusterValidate_RequirementsLibrary(t *testing.T) {
+	for path, valid := range map[string]bool{
+		"/Workspace/Shared/requirements.txt":          true,
+		"/Volumes/main/default/libs/requirements.txt": true,
+		"dbfs:/FileStore/requirements.txt":            false,
+	} {
+		diags := ResourceCluster().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"num_workers":   2,
+			"spark_version": "7.1-scala12",
+			"node_type_id":  "i3.xlarge",
+			"library": []interface{}{
+				map[string]interface{}{
+					"requirements": path,
+				},
+			},
+		}))
+		assert.Equal(t, !valid, diags.HasError(), "%s: %v", path, diags)
+	}
+}
+
+func TestResourceClusterRead_FailedLibrariesAreMissing(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{
+					LibraryStatuses: []LibraryStatus{
+						{
+							Library: &Library{
+								Pypi: &PyPi{
+									Package: "requests",
+								},
+							},
+							Status: "INSTALLED",
+						},
+						{
+							Library: &Library{
+								Whl: "dbfs:/FileStore/app.whl",
+							},
+							Status:   "FAILED",
+							Messages: []string{"Library installation failed"},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceCluster(),
+		Read:     true,
+		ID:       "abc",
+		New:      true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	libraries := d.Get("library").(*schema.Set).List()
+	require.Len(t, libraries, 1)
+	library := libraries[0].(map[string]interface{})
+	assert.Equal(t, "", library["whl"])
+	assert.Equal(t, "requests", library["pypi"].([]interface{})[0].(map[string]interface{})["package"])
+}
+
+func TestResourceClusterRead_LibrariesMissingOnRecreatedCluster(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateRunning,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				// libraries are gone after the cluster was re-created outside of terraform
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{
+					ClusterID: "abc",
+				},
+			},
+		},
+		Resource: ResourceCluster(),
+		Read:     true,
+		ID:       "abc",
+		HCL: `num_workers = 100
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		library {
+			whl = "dbfs:/FileStore/app.whl"
+		}`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	// library is missing from the state, so that it's installed again on the next apply
+	assert.Equal(t, 0, d.Get("library.#"))
+}
@@ -56,19 +56,9 @@ func (a InstancePoolsAPI) Delete(instancePoolID string) error {
 // ResourceInstancePool ...
 func ResourceInstancePool() *schema.Resource {
 	s := common.StructToSchema(InstancePool{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
-		s["aws_attributes"].ForceNew = true
-		s["node_type_id"].ForceNew = true
-		s["custom_tags"].ForceNew = true
-		s["preloaded_spark_versions"].ForceNew = true
-		s["preloaded_docker_image"].ForceNew = true
-		s["azure_attributes"].ForceNew = true
-		s["disk_spec"].ForceNew = true
-		s["enable_elastic_disk"].ForceNew = true
 		s["enable_elastic_disk"].Default = true
 		s["aws_attributes"].ConflictsWith = []string{"azure_attributes"}
 		s["azure_attributes"].ConflictsWith = []string{"aws_attributes"}
-		s["aws_attributes"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("aws_attributes.#")
-		s["azure_attributes"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("azure_attributes.#")
 		if v, err := common.SchemaPath(s, "aws_attributes", "availability"); err == nil {
 			v.ForceNew = true
 			v.Default = AwsAvailabilitySpot
@@ -123,7 +113,15 @@ func ResourceInstancePool() *schema.Resource {
 		return s
 	})
 	return common.Resource{
-		Schema: s,
+		Schema:        s,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    InstancePoolV0(s),
+				Upgrade: migrateInstancePoolV0,
+			},
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ip InstancePool
 			if err := common.DataToStructPointer(d, s, &ip); err != nil {
@@ -82,6 +82,36 @@ func (a JobsAPI) RunNow(jobID int64) (int64, error) {
 	return jr.RunID, err
 }
 
+// RunNowWithParameters triggers the job with overriding parameters and returns a run ID
+func (a JobsAPI) RunNowWithParameters(params RunParameters) (int64, error) {
+	var jr JobRun
+	err := a.client.Post(a.context, "/jobs/run-now", params, &jr)
+	return jr.RunID, err
+}
+
+// WaitForRunTermination waits until the run reaches one of terminal states and fails,
+// if the run did not succeed
+func (a JobsAPI) WaitForRunTermination(runID int64, timeout time.Duration) (run JobRun, err error) {
+	err = resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		run, err = a.RunsGet(runID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		switch run.State.LifeCycleState {
+		case "TERMINATED", "SKIPPED", "INTERNAL_ERROR":
+			if run.State.ResultState == "SUCCESS" {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("run %d of job %d is %s with %s: %s",
+				runID, run.JobID, run.State.LifeCycleState, run.State.ResultState,
+				run.State.StateMessage))
+		}
+		return resource.RetryableError(fmt.Errorf("run %d is %s: %s", runID,
+			run.State.LifeCycleState, run.State.StateMessage))
+	})
+	return
+}
+
 // RunsGet to retrieve information about the run
 func (a JobsAPI) RunsGet(runID int64) (JobRun, error) {
 	var jr JobRun
@@ -211,6 +241,9 @@ var jobSchema = common.StructToSchema(JobSettings{},
 				return false
 			}
 		}
+		if v, ok := s["new_cluster"].Elem.(*schema.Resource); ok {
+			addSecretReferenceHandling(v.Schema)
+		}
 		if v, err := common.SchemaPath(s, "new_cluster", "aws_attributes"); err == nil {
 			v.DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("new_cluster.0.aws_attributes.#")
 		}
@@ -220,6 +253,7 @@ var jobSchema = common.StructToSchema(JobSettings{},
 		if v, err := common.SchemaPath(s, "new_cluster", "gcp_attributes"); err == nil {
 			v.DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("new_cluster.0.gcp_attributes.#")
 		}
+		addLibrarySchemaValidation(s)
 		s["email_notifications"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("email_notifications.#")
 		s["max_concurrent_runs"].ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(1))
 		s["url"] = &schema.Schema{
@@ -238,17 +272,28 @@ var jobSchema = common.StructToSchema(JobSettings{},
 func ResourceJob() *schema.Resource {
 	return common.Resource{
 		Schema:        jobSchema,
-		SchemaVersion: 2,
+		SchemaVersion: 3,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 2,
+				Type:    JobV2(),
+				Upgrade: migrateJobV2,
+			},
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
 			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
 		},
-		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
 			alwaysRunning := d.Get("always_running").(bool)
 			maxConcurrentRuns := d.Get("max_concurrent_runs").(int)
 			if alwaysRunning && maxConcurrentRuns > 1 {
 				return fmt.Errorf("`always_running` must be specified only with `max_concurrent_runs = 1`")
 			}
+			if _, ok := d.GetOk("new_cluster"); ok {
+				newClusterSchema := jobSchema["new_cluster"].Elem.(*schema.Resource).Schema
+				return customizeDiffWithPolicy(ctx, d, c, newClusterSchema, "new_cluster.0.")
+			}
 			return nil
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
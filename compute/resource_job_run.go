@@ -0,0 +1,100 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+)
+
+// jobRunEntity has the same parameters as RunParameters, but the job is required
+type jobRunEntity struct {
+	JobID             int64             `json:"job_id"`
+	NotebookParams    map[string]string `json:"notebook_params,omitempty"`
+	JarParams         []string          `json:"jar_params,omitempty"`
+	PythonParams      []string          `json:"python_params,omitempty"`
+	SparkSubmitParams []string          `json:"spark_submit_params,omitempty"`
+}
+
+// ResourceJobRun triggers a run of existing job on creation and whenever triggers change
+func ResourceJobRun() *schema.Resource {
+	s := common.StructToSchema(jobRunEntity{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["triggers"] = &schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+		s["wait_for_completion"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
+		s["run_id"] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+		s["run_page_url"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+		s["life_cycle_state"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+		s["result_state"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var run jobRunEntity
+			if err := common.DataToStructPointer(d, s, &run); err != nil {
+				return err
+			}
+			jobsAPI := NewJobsAPI(ctx, c)
+			runID, err := jobsAPI.RunNowWithParameters(RunParameters(run))
+			if err != nil {
+				return err
+			}
+			d.SetId(fmt.Sprintf("%d", runID))
+			if !d.Get("wait_for_completion").(bool) {
+				return nil
+			}
+			_, err = jobsAPI.WaitForRunTermination(runID, d.Timeout(schema.TimeoutCreate))
+			return err
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			runID, err := strconv.ParseInt(d.Id(), 10, 64)
+			if err != nil {
+				return err
+			}
+			run, err := NewJobsAPI(ctx, c).RunsGet(runID)
+			if e, ok := err.(common.APIError); ok && e.IsMissing() {
+				// history of runs is retained only for a limited time, though the run
+				// itself has already happened and must not be triggered again
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			d.Set("run_id", run.RunID)
+			d.Set("run_page_url", run.RunPageURL)
+			d.Set("life_cycle_state", run.State.LifeCycleState)
+			d.Set("result_state", run.State.ResultState)
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// completed runs cannot be undone and remain in the history of the job
+			return nil
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
+		},
+	}.ToResource()
+}
@@ -0,0 +1,168 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceJobRunCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/run-now",
+				ExpectedRequest: RunParameters{
+					JobID: 123,
+					NotebookParams: map[string]string{
+						"version": "42",
+					},
+				},
+				Response: JobRun{
+					RunID: 890,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/get?run_id=890",
+				Response: JobRun{
+					JobID:      123,
+					RunID:      890,
+					RunPageURL: "https://example.com/#job/123/run/1",
+					State: RunState{
+						LifeCycleState: "RUNNING",
+					},
+				},
+			},
+		},
+		Resource: ResourceJobRun(),
+		Create:   true,
+		HCL: `job_id = 123
+		notebook_params = {
+			version = "42"
+		}
+		triggers = {
+			migration = "v42"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "890", d.Id())
+	assert.Equal(t, 890, d.Get("run_id"))
+	assert.Equal(t, "RUNNING", d.Get("life_cycle_state"))
+	assert.Equal(t, "https://example.com/#job/123/run/1", d.Get("run_page_url"))
+}
+
+func TestResourceJobRunCreate_WaitForCompletion(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/run-now",
+				ExpectedRequest: RunParameters{
+					JobID:        123,
+					PythonParams: []string{"--dry-run"},
+				},
+				Response: JobRun{
+					RunID: 890,
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/jobs/runs/get?run_id=890",
+				ReuseRequest: true,
+				Responses: []interface{}{
+					JobRun{
+						JobID: 123,
+						RunID: 890,
+						State: RunState{
+							LifeCycleState: "RUNNING",
+						},
+					},
+					JobRun{
+						JobID: 123,
+						RunID: 890,
+						State: RunState{
+							LifeCycleState: "TERMINATED",
+							ResultState:    "SUCCESS",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceJobRun(),
+		Create:   true,
+		HCL: `job_id = 123
+		python_params = ["--dry-run"]
+		wait_for_completion = true`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "890", d.Id())
+	assert.Equal(t, "SUCCESS", d.Get("result_state"))
+}
+
+func TestResourceJobRunCreate_Failed(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/run-now",
+				Response: JobRun{
+					RunID: 890,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/get?run_id=890",
+				Response: JobRun{
+					JobID: 123,
+					RunID: 890,
+					State: RunState{
+						LifeCycleState: "TERMINATED",
+						ResultState:    "FAILED",
+						StateMessage:   "Notebook failed",
+					},
+				},
+			},
+		},
+		Resource: ResourceJobRun(),
+		Create:   true,
+		HCL: `job_id = 123
+		wait_for_completion = true`,
+	}.Apply(t)
+	assert.EqualError(t, err, "run 890 of job 123 is TERMINATED with FAILED: Notebook failed")
+}
+
+func TestResourceJobRunRead_Purged(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/runs/get?run_id=890",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Run 890 does not exist.",
+				},
+			},
+		},
+		Resource: ResourceJobRun(),
+		Read:     true,
+		ID:       "890",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "890", d.Id())
+}
+
+func TestResourceJobRunDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Resource: ResourceJobRun(),
+		Delete:   true,
+		ID:       "890",
+		HCL:      `job_id = 123`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "890", d.Id())
+}
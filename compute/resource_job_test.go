@@ -432,8 +432,8 @@ func TestResourceJobRead(t *testing.T) {
 
 	assert.Equal(t, "Featurizer", d.Get("name"))
 	assert.Equal(t, 2, d.Get("library.#"))
-	assert.Equal(t, "dbfs://ff/gg/hh.jar", d.Get("library.1850263921.jar"))
-	assert.Equal(t, "dbfs://aa/bb/cc.jar", d.Get("library.587400796.jar"))
+	assert.Equal(t, "dbfs://ff/gg/hh.jar", d.Get("library.2342373317.jar"))
+	assert.Equal(t, "dbfs://aa/bb/cc.jar", d.Get("library.2545543641.jar"))
 
 	assert.Equal(t, 2, d.Get("spark_jar_task.0.parameters.#"))
 	assert.Equal(t, "com.labs.BarMain", d.Get("spark_jar_task.0.main_class_name"))
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 )
@@ -55,17 +56,81 @@ type filters struct {
 	Exclude []string `json:"exclude,omitempty"`
 }
 
+type ingestionSchemaSpec struct {
+	SourceCatalog      string `json:"source_catalog,omitempty"`
+	SourceSchema       string `json:"source_schema"`
+	DestinationCatalog string `json:"destination_catalog"`
+	DestinationSchema  string `json:"destination_schema"`
+}
+
+type ingestionTableSpec struct {
+	SourceCatalog      string `json:"source_catalog,omitempty"`
+	SourceSchema       string `json:"source_schema,omitempty"`
+	SourceTable        string `json:"source_table"`
+	DestinationCatalog string `json:"destination_catalog"`
+	DestinationSchema  string `json:"destination_schema"`
+	DestinationTable   string `json:"destination_table,omitempty"`
+}
+
+type ingestionObject struct {
+	Schema *ingestionSchemaSpec `json:"schema,omitempty"`
+	Table  *ingestionTableSpec  `json:"table,omitempty"`
+}
+
+type tableConfiguration struct {
+	PrimaryKeys []string `json:"primary_keys,omitempty"`
+	ScdType     string   `json:"scd_type,omitempty"`
+}
+
+// ingestionDefinition describes managed ingestion pipeline, that replicates objects
+// from the source behind Unity Catalog connection or ingestion gateway
+type ingestionDefinition struct {
+	ConnectionName     string              `json:"connection_name,omitempty"`
+	IngestionGatewayID string              `json:"ingestion_gateway_id,omitempty"`
+	Objects            []ingestionObject   `json:"objects" tf:"alias:object"`
+	TableConfiguration *tableConfiguration `json:"table_configuration,omitempty"`
+}
+
+// gatewayDefinition describes ingestion gateway pipeline, that stages changes
+// of database sources, like SQL Server, in Unity Catalog volume
+type gatewayDefinition struct {
+	ConnectionName        string `json:"connection_name"`
+	GatewayStorageCatalog string `json:"gateway_storage_catalog"`
+	GatewayStorageSchema  string `json:"gateway_storage_schema"`
+	GatewayStorageName    string `json:"gateway_storage_name,omitempty" tf:"computed"`
+}
+
+type notification struct {
+	EmailRecipients []string `json:"email_recipients"`
+	Alerts          []string `json:"alerts"`
+}
+
 type pipelineSpec struct {
-	ID                  string            `json:"id,omitempty" tf:"computed"`
-	Name                string            `json:"name,omitempty"`
-	Storage             string            `json:"storage,omitempty"`
-	Configuration       map[string]string `json:"configuration,omitempty"`
-	Clusters            []pipelineCluster `json:"clusters,omitempty" tf:"slice_set,alias:cluster"`
-	Libraries           []pipelineLibrary `json:"libraries,omitempty" tf:"slice_set,alias:library"`
-	Filters             *filters          `json:"filters"`
-	Continuous          bool              `json:"continuous,omitempty"`
-	AllowDuplicateNames bool              `json:"allow_duplicate_names,omitempty"`
-	Target              string            `json:"target,omitempty"`
+	ID                  string               `json:"id,omitempty" tf:"computed"`
+	Name                string               `json:"name,omitempty"`
+	Storage             string               `json:"storage,omitempty"`
+	Configuration       map[string]string    `json:"configuration,omitempty"`
+	Clusters            []pipelineCluster    `json:"clusters,omitempty" tf:"slice_set,alias:cluster"`
+	Libraries           []pipelineLibrary    `json:"libraries,omitempty" tf:"slice_set,alias:library"`
+	Filters             *filters             `json:"filters,omitempty"`
+	Continuous          bool                 `json:"continuous,omitempty"`
+	AllowDuplicateNames bool                 `json:"allow_duplicate_names,omitempty"`
+	Target              string               `json:"target,omitempty"`
+	Serverless          bool                 `json:"serverless,omitempty"`
+	Photon              bool                 `json:"photon,omitempty"`
+	Channel             string               `json:"channel,omitempty" tf:"computed"`
+	Notifications       []notification       `json:"notifications,omitempty" tf:"alias:notification"`
+	BudgetPolicyID      string               `json:"budget_policy_id,omitempty"`
+	IngestionDefinition *ingestionDefinition `json:"ingestion_definition,omitempty"`
+	GatewayDefinition   *gatewayDefinition   `json:"gateway_definition,omitempty"`
+}
+
+func (s pipelineSpec) validate() error {
+	if len(s.Libraries) == 0 && s.IngestionDefinition == nil && s.GatewayDefinition == nil {
+		return fmt.Errorf("at least one library is required, unless pipeline " +
+			"has ingestion_definition or gateway_definition")
+	}
+	return nil
 }
 
 type createPipelineResponse struct {
@@ -198,6 +263,7 @@ func adjustPipelineResourceSchema(m map[string]*schema.Schema) map[string]*schem
 	clusters, _ := m["cluster"].Elem.(*schema.Resource)
 	clustersSchema := clusters.Schema
 	clustersSchema["spark_conf"].DiffSuppressFunc = sparkConfDiffSuppressFunc
+	addSecretReferenceHandling(clustersSchema)
 
 	awsAttributes, _ := clustersSchema["aws_attributes"].Elem.(*schema.Resource)
 	awsAttributesSchema := awsAttributes.Schema
@@ -208,7 +274,24 @@ func adjustPipelineResourceSchema(m map[string]*schema.Schema) map[string]*schem
 	delete(awsAttributesSchema, "ebs_volume_count")
 	delete(awsAttributesSchema, "ebs_volume_size")
 
-	m["library"].MinItems = 1
+	m["ingestion_definition"].ConflictsWith = []string{"library", "gateway_definition"}
+	m["gateway_definition"].ConflictsWith = []string{"library", "ingestion_definition"}
+	m["gateway_definition"].ForceNew = true
+	ingestion, _ := m["ingestion_definition"].Elem.(*schema.Resource)
+	ingestion.Schema["connection_name"].ForceNew = true
+	ingestion.Schema["ingestion_gateway_id"].ForceNew = true
+	ingestion.Schema["object"].MinItems = 1
+	tableConfiguration, _ := ingestion.Schema["table_configuration"].Elem.(*schema.Resource)
+	tableConfiguration.Schema["scd_type"].ValidateFunc = validation.StringInSlice([]string{
+		"SCD_TYPE_1", "SCD_TYPE_2"}, false)
+	m["serverless"].ConflictsWith = []string{"cluster", "photon"}
+	m["channel"].ValidateFunc = validation.StringInSlice([]string{"CURRENT", "PREVIEW"}, true)
+
+	notification, _ := m["notification"].Elem.(*schema.Resource)
+	notification.Schema["email_recipients"].MinItems = 1
+	notification.Schema["alerts"].MinItems = 1
+	notification.Schema["alerts"].Elem.(*schema.Schema).ValidateFunc = validation.StringInSlice([]string{
+		"on-update-success", "on-update-failure", "on-update-fatal-failure", "on-flow-failure"}, false)
 
 	return m
 }
@@ -224,6 +307,9 @@ func ResourcePipeline() *schema.Resource {
 			if err != nil {
 				return err
 			}
+			if err = s.validate(); err != nil {
+				return err
+			}
 			api := newPipelinesAPI(ctx, c)
 			id, err := api.create(s, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
@@ -247,6 +333,9 @@ func ResourcePipeline() *schema.Resource {
 			if err := common.DataToStructPointer(d, pipelineSchema, &s); err != nil {
 				return err
 			}
+			if err := s.validate(); err != nil {
+				return err
+			}
 			return newPipelinesAPI(ctx, c).update(d.Id(), s, d.Timeout(schema.TimeoutUpdate))
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
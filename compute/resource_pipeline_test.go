@@ -506,3 +506,259 @@ func TestResourcePipelineDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abcd", d.Id())
 }
+
+func TestResourcePipelineCreate_Serverless(t *testing.T) {
+	spec := pipelineSpec{
+		Name:    "test-pipeline",
+		Storage: "/test/storage",
+		Libraries: []pipelineLibrary{
+			{
+				Notebook: &notebookLibrary{
+					Path: "/Shared/dlt",
+				},
+			},
+		},
+		Serverless: true,
+		Channel:    "PREVIEW",
+		Notifications: []notification{
+			{
+				EmailRecipients: []string{"ops@example.com"},
+				Alerts:          []string{"on-update-failure", "on-flow-failure"},
+			},
+		},
+		BudgetPolicyID: "budget-1",
+		Filters: &filters{
+			Include: []string{"com.databricks.include"},
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/pipelines",
+				ExpectedRequest: spec,
+				Response: createPipelineResponse{
+					PipelineID: "abcd",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/pipelines/abcd",
+				ReuseRequest: true,
+				Response: map[string]interface{}{
+					"id":    "abcd",
+					"name":  "test-pipeline",
+					"state": "RUNNING",
+					"spec":  spec,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "test-pipeline"
+		storage = "/test/storage"
+		library {
+		  notebook {
+			path = "/Shared/dlt"
+		  }
+		}
+		filters {
+		  include = ["com.databricks.include"]
+		}
+		serverless = true
+		channel = "PREVIEW"
+		notification {
+		  email_recipients = ["ops@example.com"]
+		  alerts = ["on-update-failure", "on-flow-failure"]
+		}
+		budget_policy_id = "budget-1"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abcd", d.Id())
+	assert.Equal(t, true, d.Get("serverless"))
+	assert.Equal(t, "PREVIEW", d.Get("channel"))
+	assert.Equal(t, "ops@example.com", d.Get("notification.0.email_recipients.0"))
+}
+
+func TestResourcePipelineCreate_ServerlessConflictsWithCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "test-pipeline"
+		library {
+		  notebook {
+			path = "/Shared/dlt"
+		  }
+		}
+		filters {
+		  include = ["com.databricks.include"]
+		}
+		cluster {
+		  label = "default"
+		  num_workers = 1
+		}
+		serverless = true
+		`,
+	}.ExpectError(t, "invalid config supplied. [serverless] Conflicting configuration arguments")
+}
+
+func TestResourcePipelineCreate_InvalidAlert(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "test-pipeline"
+		library {
+		  notebook {
+			path = "/Shared/dlt"
+		  }
+		}
+		filters {
+		  include = ["com.databricks.include"]
+		}
+		notification {
+		  email_recipients = ["ops@example.com"]
+		  alerts = ["on-coffee-break"]
+		}
+		`,
+	}.Apply(t)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "got on-coffee-break")
+}
+
+func TestResourcePipelineCreate_Ingestion(t *testing.T) {
+	spec := pipelineSpec{
+		Name: "salesforce",
+		IngestionDefinition: &ingestionDefinition{
+			ConnectionName: "salesforce",
+			Objects: []ingestionObject{
+				{
+					Table: &ingestionTableSpec{
+						SourceSchema:       "objects",
+						SourceTable:        "Account",
+						DestinationCatalog: "main",
+						DestinationSchema:  "crm",
+					},
+				},
+				{
+					Schema: &ingestionSchemaSpec{
+						SourceSchema:       "reports",
+						DestinationCatalog: "main",
+						DestinationSchema:  "crm_reports",
+					},
+				},
+			},
+			TableConfiguration: &tableConfiguration{
+				ScdType: "SCD_TYPE_2",
+			},
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/pipelines",
+				ExpectedRequest: spec,
+				Response: createPipelineResponse{
+					PipelineID: "abcd",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/pipelines/abcd",
+				ReuseRequest: true,
+				Response: map[string]interface{}{
+					"id":    "abcd",
+					"name":  "salesforce",
+					"state": "IDLE",
+					"spec":  spec,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "salesforce"
+		ingestion_definition {
+		  connection_name = "salesforce"
+		  object {
+			table {
+			  source_schema = "objects"
+			  source_table = "Account"
+			  destination_catalog = "main"
+			  destination_schema = "crm"
+			}
+		  }
+		  object {
+			schema {
+			  source_schema = "reports"
+			  destination_catalog = "main"
+			  destination_schema = "crm_reports"
+			}
+		  }
+		  table_configuration {
+			scd_type = "SCD_TYPE_2"
+		  }
+		}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abcd", d.Id())
+	assert.Equal(t, "Account", d.Get("ingestion_definition.0.object.0.table.0.source_table"))
+}
+
+func TestResourcePipelineCreate_Gateway(t *testing.T) {
+	spec := pipelineSpec{
+		Name:       "sqlserver-gateway",
+		Continuous: true,
+		GatewayDefinition: &gatewayDefinition{
+			ConnectionName:        "sqlserver",
+			GatewayStorageCatalog: "main",
+			GatewayStorageSchema:  "staging",
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/pipelines",
+				ExpectedRequest: spec,
+				Response: createPipelineResponse{
+					PipelineID: "abcd",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/pipelines/abcd",
+				ReuseRequest: true,
+				Response: map[string]interface{}{
+					"id":    "abcd",
+					"name":  "sqlserver-gateway",
+					"state": "RUNNING",
+					"spec":  spec,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL: `name = "sqlserver-gateway"
+		continuous = true
+		gateway_definition {
+		  connection_name = "sqlserver"
+		  gateway_storage_catalog = "main"
+		  gateway_storage_schema = "staging"
+		}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abcd", d.Id())
+	assert.Equal(t, "staging", d.Get("gateway_definition.0.gateway_storage_schema"))
+}
+
+func TestResourcePipelineCreate_NoLibraries(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourcePipeline(),
+		HCL:      `name = "test-pipeline"`,
+	}.ExpectError(t, "at least one library is required, unless pipeline has "+
+		"ingestion_definition or gateway_definition")
+}
@@ -2,9 +2,12 @@ package exporter
 
 import (
 	"flag"
+	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 )
@@ -67,6 +70,15 @@ func Run(args ...string) error {
 	flags.BoolVar(&ic.mounts, "mounts", false, "List DBFS mount points.")
 	flags.BoolVar(&ic.generateDeclaration, "generateProviderDeclaration", false,
 		"Generate Databricks provider declaration (for Terraform >= 0.13).")
+	flags.BoolVar(&ic.hostVariable, "hostVariable", false,
+		"Set host in generated provider declaration to databricks_host variable.")
+	flags.IntVar(&ic.parallelism, "parallelism", 1,
+		"Number of resources, that are imported concurrently. Defaults to 1.")
+	flags.BoolVar(&ic.importBlocks, "importBlocks", false,
+		"Generate import.tf with import blocks (for Terraform >= 1.5) instead of import.sh.")
+	flags.BoolVar(&ic.modules, "modules", false,
+		"Generate every group of services as a module in modules directory, "+
+			"that is instantiated by modules.tf.")
 	services, listing := ic.allServicesAndListing()
 	flags.StringVar(&ic.services, "services", services,
 		"Comma-separated list of services to import. By default all services are imported.")
@@ -77,6 +89,12 @@ func Run(args ...string) error {
 	flags.StringVar(&ic.match, "match", "", "Match resource names during listing operation. "+
 		"This filter applies to all resources that are getting listed, so if you want to import "+
 		"all dependencies of just one cluster, specify -listing=compute")
+	matchRegex := ""
+	flags.StringVar(&matchRegex, "matchRegex", "", "Match resource names during listing operation "+
+		"with regular expression, e.g. ^prod-. Could be combined with -match.")
+	flags.StringVar(&ic.updatedSince, "updated-since", "",
+		"Only export objects, that were modified after given RFC3339 timestamp, "+
+			"e.g. 2021-06-01T00:00:00Z. Generated files from previous run are updated in place.")
 	prefix := ""
 	flags.StringVar(&prefix, "prefix", "", "Prefix that will be added to the name of all exported resources")
 	newArgs := args
@@ -90,6 +108,20 @@ func Run(args ...string) error {
 	if len(prefix) > 0 {
 		ic.prefix = prefix + "_"
 	}
+	if matchRegex != "" {
+		ic.matchRegex, err = regexp.Compile(matchRegex)
+		if err != nil {
+			return fmt.Errorf("invalid -matchRegex: %w", err)
+		}
+	}
+	if ic.updatedSince != "" {
+		updatedSince, err := time.Parse(time.RFC3339, ic.updatedSince)
+		if err != nil {
+			return fmt.Errorf("invalid -updated-since: %w", err)
+		}
+		ic.incremental = true
+		ic.updatedSinceMs = updatedSince.UnixNano() / int64(time.Millisecond)
+	}
 	if ic.debug {
 		logLevel = append(logLevel, "[DEBUG]")
 	}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"crypto/md5"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/compute"
@@ -69,10 +72,44 @@ type importContext struct {
 	services            string
 	listing             string
 	match               string
+	matchRegex          *regexp.Regexp
 	lastActiveDays      int64
+	incremental         bool
+	updatedSince        string
+	updatedSinceMs      int64
 	generateDeclaration bool
+	hostVariable        bool
+	importBlocks        bool
+	modules             bool
+	parallelism         int
 	meAdmin             bool
 	prefix              string
+
+	// guards importing, State, Scope and progress, when resources are imported in parallel
+	stateMutex  sync.RWMutex
+	groupsMutex sync.Mutex
+	workers     chan struct{}
+	waitGroup   sync.WaitGroup
+	// number of imported resources per service
+	progress map[string]int
+
+	// file, that is currently generated, and variables used in each of generated files
+	generating    string
+	fileVariables map[string]map[string]bool
+}
+
+// serviceFiles groups closely related services into the same generated file
+var serviceFiles = map[string]string{
+	"users":  "identity",
+	"groups": "identity",
+}
+
+// serviceFile returns name of generated file (and module) for the service, e.g. `identity`
+func serviceFile(service string) string {
+	if file, ok := serviceFiles[service]; ok {
+		return file
+	}
+	return service
 }
 
 type mount struct {
@@ -111,8 +148,10 @@ func newImportContext(c *common.DatabricksClient) *importContext {
 		},
 		hclFixes: []regexFix{ // Be careful with that! it may break working code
 		},
-		allUsers:  []identity.ScimUser{},
-		variables: map[string]string{},
+		allUsers:      []identity.ScimUser{},
+		variables:     map[string]string{},
+		fileVariables: map[string]map[string]bool{},
+		progress:      map[string]int{},
 	}
 }
 
@@ -143,6 +182,10 @@ func (ic *importContext) Run() error {
 			break
 		}
 	}
+	if ic.parallelism > 1 {
+		log.Printf("[INFO] Importing resources with %d workers", ic.parallelism)
+		ic.workers = make(chan struct{}, ic.parallelism)
+	}
 	for resourceName, ir := range ic.Importables {
 		if ir.List == nil {
 			continue
@@ -156,16 +199,29 @@ func (ic *importContext) Run() error {
 			return err
 		}
 	}
+	// listed resources and their dependencies are still being imported by workers
+	ic.waitGroup.Wait()
+	for _, service := range ic.importedServices() {
+		log.Printf("[INFO] Imported %d resources of %s service", ic.progress[service], service)
+	}
 	if len(ic.Scope) == 0 {
+		if ic.incremental {
+			log.Printf("[INFO] No resources were updated since %s", ic.updatedSince)
+			return nil
+		}
 		return fmt.Errorf("no resources to import")
 	}
-	sh, err := os.OpenFile(fmt.Sprintf("%s/import.sh", ic.Directory), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
-	if err != nil {
-		return err
+	var sh *os.File
+	if ic.importBlocks {
+		// import blocks are written to import.tf together with other generated files
+		ic.Files["import"] = ic.loadFile("import")
+	} else {
+		sh, err = ic.openImportScript()
+		if err != nil {
+			return err
+		}
+		defer sh.Close()
 	}
-	defer sh.Close()
-	// nolint
-	sh.WriteString("#!/bin/sh\n\n")
 
 	if ic.generateDeclaration {
 		dcfile, err := os.Create(fmt.Sprintf("%s/databricks.tf", ic.Directory))
@@ -184,8 +240,14 @@ func (ic *importContext) Run() error {
 		  	}
 
 		  	provider "databricks" {
-		  	}
 		  	`)
+		if ic.hostVariable {
+			// nolint
+			dcfile.WriteString("host = var.databricks_host\n")
+			ic.variables["databricks_host"] = "URL of Databricks workspace"
+		}
+		// nolint
+		dcfile.WriteString("}\n")
 		dcfile.Close()
 	}
 
@@ -194,15 +256,18 @@ func (ic *importContext) Run() error {
 	log.Printf("[INFO] Generating configuration for %d resources", scopeSize)
 	for i, r := range ic.Scope {
 		ir := ic.Importables[r.Resource]
-		f, ok := ic.Files[ir.Service]
+		ic.generating = serviceFile(ir.Service)
+		f, ok := ic.Files[ic.generating]
 		if !ok {
-			f = hclwrite.NewEmptyFile()
-			ic.Files[ir.Service] = f
+			f = ic.loadFile(ic.generating)
+			ic.Files[ic.generating] = f
 		}
 		if ir.Ignore != nil && ir.Ignore(ic, r) {
 			continue
 		}
 		body := f.Body()
+		// updated resources replace their blocks from previous runs and are already in the state
+		previouslyExported := ic.incremental && removeBlock(body, r)
 		if ir.Body != nil {
 			err := ir.Body(ic, body, r)
 			if err != nil {
@@ -219,18 +284,26 @@ func (ic *importContext) Run() error {
 		if i%50 == 0 {
 			log.Printf("[INFO] Generated %d of %d resources", i, scopeSize)
 		}
-		if r.Mode != "data" {
-			// nolint
-			sh.WriteString(r.ImportCommand(ic) + "\n")
+		if r.Mode != "data" && !previouslyExported {
+			if ic.importBlocks {
+				r.ImportBlock(ic, ic.Files["import"].Body())
+			} else {
+				// nolint
+				sh.WriteString(r.ImportCommand(ic) + "\n")
+			}
 		}
 	}
-	for service, f := range ic.Files {
+	ic.generating = ""
+	for name, f := range ic.Files {
 		formatted := hclwrite.Format(f.Bytes())
 		// fix some formatting in a hacky way instead of writing 100 lines
 		// of HCL AST writer code
 		formatted = []byte(ic.regexFix(string(formatted), ic.hclFixes))
 		log.Printf("[DEBUG] %s", formatted)
-		generatedFile := fmt.Sprintf("%s/%s.tf", ic.Directory, service)
+		generatedFile := ic.filePath(name)
+		if err = os.MkdirAll(filepath.Dir(generatedFile), 0755); err != nil {
+			return err
+		}
 		if tf, err := os.Create(generatedFile); err == nil {
 			defer tf.Close()
 			if _, err = tf.Write(formatted); err != nil {
@@ -240,21 +313,17 @@ func (ic *importContext) Run() error {
 		log.Printf("[INFO] Created %s", generatedFile)
 	}
 	if len(ic.variables) > 0 {
-		vf, err := os.Create(fmt.Sprintf("%s/vars.tf", ic.Directory))
+		err = ic.writeVariables(fmt.Sprintf("%s/vars.tf", ic.Directory), ic.variables)
 		if err != nil {
 			return err
 		}
-		defer vf.Close()
-		f := hclwrite.NewEmptyFile()
-		body := f.Body()
-		for k, v := range ic.variables {
-			b := body.AppendNewBlock("variable", []string{k}).Body()
-			b.SetAttributeValue("description", cty.StringVal(v))
-		}
-		// nolint
-		vf.Write(f.Bytes())
 		log.Printf("[INFO] Written %d variables", len(ic.variables))
 	}
+	if ic.modules {
+		if err = ic.writeModules(); err != nil {
+			return err
+		}
+	}
 	cmd := exec.CommandContext(context.Background(), "terraform", "fmt")
 	cmd.Dir = ic.Directory
 	err = cmd.Run()
@@ -265,7 +334,176 @@ func (ic *importContext) Run() error {
 	return nil
 }
 
+// openImportScript opens import.sh for writing of terraform import commands
+func (ic *importContext) openImportScript() (*os.File, error) {
+	shFlags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if ic.incremental {
+		// import commands of new resources are appended to the ones from previous runs
+		shFlags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+	sh, err := os.OpenFile(fmt.Sprintf("%s/import.sh", ic.Directory), shFlags, 0755)
+	if err != nil {
+		return nil, err
+	}
+	if shInfo, err := sh.Stat(); err == nil && shInfo.Size() == 0 {
+		// nolint
+		sh.WriteString("#!/bin/sh\n\n")
+	}
+	return sh, nil
+}
+
+// warnNotIncremental tells that objects without modification time are all exported,
+// even in incremental mode, so that edited ones are not skipped
+func (ic *importContext) warnNotIncremental(objects string) {
+	if ic.incremental {
+		log.Printf("[WARN] %s have no modification time, all of them are exported", objects)
+	}
+}
+
+// isUpdatedSince tells if object was modified after `-updated-since` timestamp.
+// All objects are considered updated, unless exporter runs in incremental mode.
+func (ic *importContext) isUpdatedSince(modifiedMs int64) bool {
+	return !ic.incremental || modifiedMs >= ic.updatedSinceMs
+}
+
+// filePath returns path of generated file. With `-modules` every file, except
+// import blocks, is generated in its own module directory, e.g. `modules/jobs/jobs.tf`
+func (ic *importContext) filePath(name string) string {
+	if ic.modules && name != "import" {
+		return fmt.Sprintf("%s/modules/%s/%s.tf", ic.Directory, name, name)
+	}
+	return fmt.Sprintf("%s/%s.tf", ic.Directory, name)
+}
+
+// writeVariables writes declarations of variables with their descriptions in sorted order
+func (ic *importContext) writeVariables(path string, variables map[string]string) error {
+	names := []string{}
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	for _, name := range names {
+		b := body.AppendNewBlock("variable", []string{name}).Body()
+		b.SetAttributeValue("description", cty.StringVal(variables[name]))
+	}
+	return ioutil.WriteFile(path, hclwrite.Format(f.Bytes()), 0644)
+}
+
+// moduleVersions declares provider source in every module, otherwise Terraform
+// resolves hashicorp/databricks for resources of child modules
+const moduleVersions = `terraform {
+  required_providers {
+    databricks = {
+      source = "databrickslabs/databricks"
+    }
+  }
+}
+`
+
+// writeModules declares variables and required providers of every generated module
+// and writes modules.tf, that instantiates them and passes through variables from
+// the root module
+func (ic *importContext) writeModules() error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	for _, name := range ic.moduleNames() {
+		dir := fmt.Sprintf("%s/modules/%s", ic.Directory, name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(dir+"/versions.tf", []byte(moduleVersions), 0644); err != nil {
+			return err
+		}
+		variables := map[string]string{}
+		for v := range ic.fileVariables[name] {
+			variables[v] = ic.variables[v]
+		}
+		if len(variables) > 0 {
+			err := ic.writeVariables(dir+"/vars.tf", variables)
+			if err != nil {
+				return err
+			}
+		}
+		b := body.AppendNewBlock("module", []string{name}).Body()
+		b.SetAttributeValue("source", cty.StringVal("./modules/"+name))
+		passed := []string{}
+		for v := range variables {
+			passed = append(passed, v)
+		}
+		sort.Strings(passed)
+		for _, v := range passed {
+			b.SetAttributeTraversal(v, hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: v},
+			})
+		}
+		body.AppendNewline()
+	}
+	log.Printf("[INFO] Written %d modules", len(ic.moduleNames()))
+	return ioutil.WriteFile(fmt.Sprintf("%s/modules.tf", ic.Directory), hclwrite.Format(f.Bytes()), 0644)
+}
+
+// moduleNames returns sorted names of generated files, that are wrapped into modules
+func (ic *importContext) moduleNames() []string {
+	names := []string{}
+	for name := range ic.Files {
+		if name == "import" {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resourceModule returns address of the module, where resource is generated, e.g. `module.jobs`
+func (ic *importContext) resourceModule(r *resource) string {
+	address := ic.Module
+	if !ic.modules {
+		return address
+	}
+	if address != "" {
+		address += "."
+	}
+	return address + "module." + serviceFile(ic.Importables[r.Resource].Service)
+}
+
+// loadFile returns file, that was generated for the service by previous run, so
+// that updated resources are merged into it in incremental mode
+func (ic *importContext) loadFile(service string) *hclwrite.File {
+	if !ic.incremental {
+		return hclwrite.NewEmptyFile()
+	}
+	generatedFile := ic.filePath(service)
+	src, err := ioutil.ReadFile(generatedFile)
+	if err != nil {
+		return hclwrite.NewEmptyFile()
+	}
+	f, diags := hclwrite.ParseConfig(src, generatedFile, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		log.Printf("[WARN] Cannot parse %s, it'll be overwritten: %s", generatedFile, diags.Error())
+		return hclwrite.NewEmptyFile()
+	}
+	return f
+}
+
+// removeBlock removes block of the resource, that was generated by previous run
+func removeBlock(body *hclwrite.Body, r *resource) bool {
+	for _, b := range body.Blocks() {
+		labels := b.Labels()
+		if len(labels) == 2 && labels[0] == r.Resource && labels[1] == r.Name {
+			return body.RemoveBlock(b)
+		}
+	}
+	return false
+}
+
 func (ic *importContext) MatchesName(n string) bool {
+	if ic.matchRegex != nil && !ic.matchRegex.MatchString(n) {
+		return false
+	}
 	if ic.match == "" {
 		return true
 	}
@@ -273,10 +511,16 @@ func (ic *importContext) MatchesName(n string) bool {
 }
 
 func (ic *importContext) Find(r *resource, pick string) hcl.Traversal {
+	ic.stateMutex.RLock()
+	defer ic.stateMutex.RUnlock()
 	for _, sr := range ic.State.Resources {
 		if sr.Type != r.Resource {
 			continue
 		}
+		if ic.modules && ic.generating != "" && ic.generating != serviceFile(ic.Importables[sr.Type].Service) {
+			// resources of other modules can't be referenced, so the value is used as is
+			return nil
+		}
 		for _, i := range sr.Instances {
 			v := i.Attributes[r.Attribute]
 			if v == nil {
@@ -305,6 +549,13 @@ func (ic *importContext) Find(r *resource, pick string) hcl.Traversal {
 }
 
 func (ic *importContext) Has(r *resource) bool {
+	ic.stateMutex.RLock()
+	defer ic.stateMutex.RUnlock()
+	return ic.has(r)
+}
+
+// has must be called with stateMutex held
+func (ic *importContext) has(r *resource) bool {
 	if _, visiting := ic.importing[r.String()]; visiting {
 		return true
 	}
@@ -323,14 +574,16 @@ func (ic *importContext) Has(r *resource) bool {
 }
 
 func (ic *importContext) Add(r *resource) {
-	if ic.Has(r) {
-		return
-	}
 	state := r.Data.State()
 	if state == nil {
 		log.Printf("[ERROR] state is nil for %s", r)
 		return
 	}
+	ic.stateMutex.Lock()
+	defer ic.stateMutex.Unlock()
+	if ic.has(r) {
+		return
+	}
 	inst := instanceApproximation{
 		Attributes: map[string]interface{}{},
 	}
@@ -350,6 +603,21 @@ func (ic *importContext) Add(r *resource) {
 	})
 	// in single-threaded scenario scope is toposorted
 	ic.Scope = append(ic.Scope, r)
+	service := ic.Importables[r.Resource].Service
+	ic.progress[service]++
+	if ic.progress[service]%50 == 0 {
+		log.Printf("[INFO] Imported %d resources of %s service", ic.progress[service], service)
+	}
+}
+
+// importedServices returns sorted names of services, that have imported resources
+func (ic *importContext) importedServices() []string {
+	services := []string{}
+	for service := range ic.progress {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
 }
 
 func (ic *importContext) regexFix(s string, fixes []regexFix) string {
@@ -381,17 +649,19 @@ func (ic *importContext) ResourceName(r *resource) string {
 }
 
 func (ic *importContext) Emit(r *resource) {
-	// TODO: change into channels, if stack trace depth issues would surface
 	_, v := r.MatchPair()
 	if v == "" {
 		log.Printf("[DEBUG] %s has got empty identifier", r)
 		return
 	}
-	if ic.Has(r) {
+	ic.stateMutex.Lock()
+	if ic.has(r) {
+		ic.stateMutex.Unlock()
 		log.Printf("[DEBUG] %s already imported", r)
 		return
 	}
 	ic.importing[r.String()] = true
+	ic.stateMutex.Unlock()
 	pr, ok := ic.Resources[r.Resource]
 	if !ok {
 		log.Printf("[ERROR] %s is not available in provider", r)
@@ -407,6 +677,23 @@ func (ic *importContext) Emit(r *resource) {
 			r.Resource, ir.Service)
 		return
 	}
+	if ic.workers == nil {
+		// TODO: change into channels, if stack trace depth issues would surface
+		ic.importResource(pr, ir, r)
+		return
+	}
+	ic.waitGroup.Add(1)
+	go func() {
+		defer ic.waitGroup.Done()
+		// emitting worker doesn't wait for this one, so nested emits can't deadlock
+		ic.workers <- struct{}{}
+		defer func() { <-ic.workers }()
+		ic.importResource(pr, ir, r)
+	}()
+}
+
+// importResource reads emitted resource, imports its dependencies and adds it to the state
+func (ic *importContext) importResource(pr *schema.Resource, ir importable, r *resource) {
 	if r.ID == "" {
 		if ir.Search == nil {
 			log.Printf("[ERROR] Searching %s is not available", r)
@@ -483,6 +770,12 @@ func (ic *importContext) reference(i importable, path []string, value string) hc
 
 func (ic *importContext) variable(name, desc string) hclwrite.Tokens {
 	ic.variables[name] = desc
+	if ic.generating != "" {
+		if _, ok := ic.fileVariables[ic.generating]; !ok {
+			ic.fileVariables[ic.generating] = map[string]bool{}
+		}
+		ic.fileVariables[ic.generating][name] = true
+	}
 	return hclwrite.TokensForTraversal(hcl.Traversal{
 		hcl.TraverseRoot{Name: "var"},
 		hcl.TraverseAttr{Name: name},
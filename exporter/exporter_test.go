@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/hcl/v2/hclwrite"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // nolint
@@ -285,6 +287,16 @@ func TestImportingUsersGroupsSecretScopes(t *testing.T) {
 				Resource: "/api/2.0/clusters/list",
 				Response: compute.ClusterList{},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/list",
+				Response: compute.ClusterPolicyList{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/catalogs",
+				Response: map[string]interface{}{},
+			},
 			{
 				Method:       "GET",
 				Resource:     "/api/2.0/secrets/scopes/list",
@@ -377,6 +389,16 @@ func TestImportingNoResourcesError(t *testing.T) {
 				Resource: "/api/2.0/clusters/list",
 				Response: compute.ClusterList{},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/list",
+				Response: compute.ClusterPolicyList{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/catalogs",
+				Response: map[string]interface{}{},
+			},
 			{
 				Method:       "GET",
 				Resource:     "/api/2.0/secrets/scopes/list",
@@ -419,6 +441,16 @@ func TestImportingClusters(t *testing.T) {
 				Resource: "/api/2.0/clusters/list",
 				Response: getJSONObject("test-data/clusters-list-response.json"),
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/list",
+				Response: compute.ClusterPolicyList{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/catalogs",
+				Response: map[string]interface{}{},
+			},
 			{
 				Method:   "GET",
 				Resource: "/api/2.0/clusters/get?cluster_id=test1",
@@ -762,6 +794,12 @@ func TestImportingWithError(t *testing.T) {
 
 	err = Run("-directory", "/bin/abcd", "-services", "groups,users", "-prefix", "abc")
 	assert.EqualError(t, err, "can't create directory /bin/abcd")
+
+	err = Run("-directory", "/bin/abcd", "-matchRegex", "^prod-(")
+	assert.EqualError(t, err, "invalid -matchRegex: error parsing regexp: missing closing ): `^prod-(`")
+
+	err = Run("-directory", "/bin/abcd", "-updated-since", "yesterday")
+	assert.EqualError(t, err, `invalid -updated-since: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`)
 }
 
 func TestImportingSecrets(t *testing.T) {
@@ -783,6 +821,16 @@ func TestImportingSecrets(t *testing.T) {
 				Resource: "/api/2.0/clusters/list",
 				Response: compute.ClusterList{},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/list",
+				Response: compute.ClusterPolicyList{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/catalogs",
+				Response: map[string]interface{}{},
+			},
 			{
 				Method:       "GET",
 				Resource:     "/api/2.0/secrets/scopes/list",
@@ -879,6 +927,366 @@ func TestImportingGlobalInitScripts(t *testing.T) {
 		})
 }
 
+func TestImportingGlobalInitScripts_Modules(t *testing.T) {
+	qa.HTTPFixturesApply(t,
+		[]qa.HTTPFixture{
+			meAdminFixture,
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/global-init-scripts",
+				ReuseRequest: true,
+				Response:     getJSONObject("test-data/global-init-scripts-list.json"),
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/global-init-scripts/C39FD6BAC8088BBC",
+				ReuseRequest: true,
+				Response:     getJSONObject("test-data/global-init-script-get1.json"),
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/global-init-scripts/F931E63C248C1D8C",
+				ReuseRequest: true,
+				Response:     getJSONObject("test-data/global-init-script-get2.json"),
+			},
+		}, func(ctx context.Context, client *common.DatabricksClient) {
+			tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+			defer os.RemoveAll(tmpDir)
+
+			ic := newImportContext(client)
+			ic.Directory = tmpDir
+			ic.listing = "workspace"
+			ic.services = "workspace"
+			ic.generateDeclaration = true
+			ic.hostVariable = true
+			ic.modules = true
+
+			err := ic.Run()
+			assert.NoError(t, err)
+
+			_, err = os.Stat(fmt.Sprintf("%s/modules/workspace/workspace.tf", tmpDir))
+			assert.NoError(t, err)
+
+			versions, err := ioutil.ReadFile(fmt.Sprintf("%s/modules/workspace/versions.tf", tmpDir))
+			assert.NoError(t, err)
+			assert.Contains(t, string(versions), `source = "databrickslabs/databricks"`)
+
+			modules, err := ioutil.ReadFile(fmt.Sprintf("%s/modules.tf", tmpDir))
+			assert.NoError(t, err)
+			assert.Contains(t, string(modules), `source = "./modules/workspace"`)
+
+			vars, err := ioutil.ReadFile(fmt.Sprintf("%s/vars.tf", tmpDir))
+			assert.NoError(t, err)
+			assert.Contains(t, string(vars), `variable "databricks_host"`)
+
+			sh, err := ioutil.ReadFile(fmt.Sprintf("%s/import.sh", tmpDir))
+			assert.NoError(t, err)
+			assert.Contains(t, string(sh), "terraform import module.workspace.databricks_global_init_script.")
+		})
+}
+
+func TestWriteModules(t *testing.T) {
+	ic := newImportContext(&common.DatabricksClient{})
+	ic.Directory = t.TempDir()
+	ic.Files["jobs"] = hclwrite.NewEmptyFile()
+	ic.Files["secrets"] = hclwrite.NewEmptyFile()
+	ic.variables["string_value_a"] = "Secret a"
+	ic.fileVariables["secrets"] = map[string]bool{"string_value_a": true}
+	require.NoError(t, ic.writeModules())
+
+	for _, name := range []string{"jobs", "secrets"} {
+		versions, err := ioutil.ReadFile(fmt.Sprintf("%s/modules/%s/versions.tf", ic.Directory, name))
+		require.NoError(t, err)
+		assert.Contains(t, string(versions), `source = "databrickslabs/databricks"`)
+	}
+	vars, err := ioutil.ReadFile(fmt.Sprintf("%s/modules/secrets/vars.tf", ic.Directory))
+	require.NoError(t, err)
+	assert.Contains(t, string(vars), `variable "string_value_a"`)
+	modules, err := ioutil.ReadFile(fmt.Sprintf("%s/modules.tf", ic.Directory))
+	require.NoError(t, err)
+	assert.Contains(t, string(modules), `string_value_a = var.string_value_a`)
+}
+
+func TestImportingGlobalInitScripts_Parallel(t *testing.T) {
+	qa.HTTPFixturesApply(t,
+		[]qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/global-init-scripts",
+				ReuseRequest: true,
+				Response:     getJSONObject("test-data/global-init-scripts-list.json"),
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/global-init-scripts/C39FD6BAC8088BBC",
+				ReuseRequest: true,
+				Response:     getJSONObject("test-data/global-init-script-get1.json"),
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/global-init-scripts/F931E63C248C1D8C",
+				ReuseRequest: true,
+				Response:     getJSONObject("test-data/global-init-script-get2.json"),
+			},
+		}, func(ctx context.Context, client *common.DatabricksClient) {
+			ic := newImportContext(client)
+			ic.services = "workspace"
+			ic.workers = make(chan struct{}, 2)
+
+			err := resourcesMap["databricks_global_init_script"].List(ic)
+			assert.NoError(t, err)
+			ic.waitGroup.Wait()
+
+			assert.Len(t, ic.Scope, 2)
+			assert.Equal(t, []string{"workspace"}, ic.importedServices())
+			assert.Equal(t, 2, ic.progress["workspace"])
+		})
+}
+
+func TestImportingGlobalInitScripts_NotUpdatedSince(t *testing.T) {
+	qa.HTTPFixturesApply(t,
+		[]qa.HTTPFixture{
+			meAdminFixture,
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/global-init-scripts",
+				ReuseRequest: true,
+				Response:     getJSONObject("test-data/global-init-scripts-list.json"),
+			},
+		}, func(ctx context.Context, client *common.DatabricksClient) {
+			tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+			defer os.RemoveAll(tmpDir)
+
+			ic := newImportContext(client)
+			ic.Directory = tmpDir
+			ic.listing = "workspace"
+			services, _ := ic.allServicesAndListing()
+			ic.services = services
+			ic.incremental = true
+			ic.updatedSince = "2021-03-01T00:00:00Z"
+			ic.updatedSinceMs = 1614556800000
+
+			err := ic.Run()
+			assert.NoError(t, err)
+			assert.Equal(t, 0, len(ic.Scope))
+		})
+}
+
+func TestImportingFunctions_List(t *testing.T) {
+	qa.HTTPFixturesApply(t,
+		[]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/catalogs",
+				Response: map[string]interface{}{
+					"catalogs": []map[string]interface{}{
+						{"name": "main"},
+						{"name": "system"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/schemas?catalog_name=main",
+				Response: map[string]interface{}{
+					"schemas": []map[string]interface{}{
+						{"name": "default", "catalog_name": "main"},
+						{"name": "information_schema", "catalog_name": "main"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/functions?catalog_name=main&schema_name=default",
+				Response: map[string]interface{}{
+					"functions": []map[string]interface{}{
+						{
+							"name":         "add_one",
+							"catalog_name": "main",
+							"schema_name":  "default",
+							"full_name":    "main.default.add_one",
+						},
+					},
+				},
+			},
+		}, func(ctx context.Context, client *common.DatabricksClient) {
+			ic := newImportContext(client)
+			err := resourcesMap["databricks_function"].List(ic)
+			assert.NoError(t, err)
+			assert.True(t, ic.Has(&resource{
+				Resource: "databricks_function",
+				ID:       "main.default.add_one",
+			}))
+
+			d := ic.Resources["databricks_function"].TestResourceData()
+			d.SetId("main.default.add_one")
+			assert.Equal(t, "main_default_add_one", resourcesMap["databricks_function"].Name(d))
+		})
+}
+
+func TestImportBlock(t *testing.T) {
+	f := hclwrite.NewEmptyFile()
+	r := &resource{
+		Resource: "databricks_job",
+		Name:     "prod_etl",
+		ID:       "123",
+	}
+	r.ImportBlock(&importContext{}, f.Body())
+	r.ImportBlock(&importContext{Module: "module.workspace"}, f.Body())
+	r.ImportBlock(&importContext{Module: "workspace"}, f.Body())
+	assert.Equal(t, `import {
+  to = databricks_job.prod_etl
+  id = "123"
+}
+
+import {
+  to = module.workspace.databricks_job.prod_etl
+  id = "123"
+}
+
+import {
+  to = module.workspace.databricks_job.prod_etl
+  id = "123"
+}
+
+`, string(hclwrite.Format(f.Bytes())))
+}
+
+func TestImportBlock_Modules(t *testing.T) {
+	f := hclwrite.NewEmptyFile()
+	r := &resource{
+		Resource: "databricks_user",
+		Name:     "me",
+		ID:       "123",
+	}
+	ic := &importContext{Importables: resourcesMap, modules: true}
+	r.ImportBlock(ic, f.Body())
+	ic.Module = "data_platform"
+	r.ImportBlock(ic, f.Body())
+	assert.Equal(t, `import {
+  to = module.identity.databricks_user.me
+  id = "123"
+}
+
+import {
+  to = module.data_platform.module.identity.databricks_user.me
+  id = "123"
+}
+
+`, string(hclwrite.Format(f.Bytes())))
+	assert.Equal(t, `terraform import data_platform.module.identity.databricks_user.me "123"`,
+		r.ImportCommand(ic))
+}
+
+func TestFilePath(t *testing.T) {
+	ic := importContext{Directory: "/tmp/x"}
+	assert.Equal(t, "/tmp/x/identity.tf", ic.filePath(serviceFile("groups")))
+	assert.Equal(t, "/tmp/x/jobs.tf", ic.filePath(serviceFile("jobs")))
+
+	ic.modules = true
+	assert.Equal(t, "/tmp/x/modules/jobs/jobs.tf", ic.filePath("jobs"))
+	assert.Equal(t, "/tmp/x/import.tf", ic.filePath("import"))
+}
+
+func TestFindAcrossModules(t *testing.T) {
+	ic := newImportContext(&common.DatabricksClient{})
+	d := ic.Resources["databricks_cluster"].TestResourceData()
+	d.SetId("abc")
+	ic.Add(&resource{
+		Resource: "databricks_cluster",
+		ID:       "abc",
+		Name:     "shared",
+		Data:     d,
+	})
+	r := &resource{
+		Resource:  "databricks_cluster",
+		Attribute: "id",
+		Value:     "abc",
+	}
+	ic.generating = "jobs"
+	assert.NotNil(t, ic.Find(r, "id"))
+
+	ic.modules = true
+	assert.Nil(t, ic.Find(r, "id"), "clusters are generated in compute module")
+
+	ic.generating = "compute"
+	assert.NotNil(t, ic.Find(r, "id"))
+}
+
+func TestVariablesOfGeneratedFile(t *testing.T) {
+	ic := newImportContext(&common.DatabricksClient{})
+	ic.generating = "secrets"
+	ic.variable("string_value_a", "Secret a")
+	assert.Equal(t, map[string]bool{"string_value_a": true}, ic.fileVariables["secrets"])
+	assert.Equal(t, "Secret a", ic.variables["string_value_a"])
+}
+
+func TestMatchesName(t *testing.T) {
+	ic := importContext{}
+	assert.True(t, ic.MatchesName("anything"))
+
+	ic.match = "ETL"
+	assert.True(t, ic.MatchesName("prod-etl-daily"))
+	assert.False(t, ic.MatchesName("prod-reports"))
+
+	ic.match = ""
+	ic.matchRegex = regexp.MustCompile(`^prod-`)
+	assert.True(t, ic.MatchesName("prod-reports"))
+	assert.False(t, ic.MatchesName("dev-prod-reports"))
+
+	ic.match = "etl"
+	assert.True(t, ic.MatchesName("prod-etl-daily"))
+	assert.False(t, ic.MatchesName("prod-reports"))
+	assert.False(t, ic.MatchesName("dev-etl-daily"))
+}
+
+func TestIsUpdatedSince(t *testing.T) {
+	ic := importContext{}
+	assert.True(t, ic.isUpdatedSince(0))
+
+	ic.incremental = true
+	ic.updatedSinceMs = 1614556800000
+	assert.False(t, ic.isUpdatedSince(1613470125117))
+	assert.True(t, ic.isUpdatedSince(1614556800000))
+}
+
+func TestIncrementalLoadFileAndRemoveBlock(t *testing.T) {
+	tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	defer os.RemoveAll(tmpDir)
+	err := os.MkdirAll(tmpDir, 0755)
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(fmt.Sprintf("%s/workspace.tf", tmpDir), []byte(`
+resource "databricks_global_init_script" "init_script1" {
+  name = "init_script1"
+}
+
+resource "databricks_global_init_script" "init_script2" {
+  name = "init_script2"
+}
+`), 0644)
+	assert.NoError(t, err)
+
+	ic := importContext{Directory: tmpDir}
+	f := ic.loadFile("workspace")
+	assert.Len(t, f.Body().Blocks(), 0, "full export starts from scratch")
+
+	ic.incremental = true
+	f = ic.loadFile("workspace")
+	assert.Len(t, f.Body().Blocks(), 2)
+	assert.True(t, removeBlock(f.Body(), &resource{
+		Resource: "databricks_global_init_script",
+		Name:     "init_script1",
+	}))
+	assert.False(t, removeBlock(f.Body(), &resource{
+		Resource: "databricks_global_init_script",
+		Name:     "init_script3",
+	}))
+	assert.Len(t, f.Body().Blocks(), 1)
+
+	f = ic.loadFile("compute")
+	assert.Len(t, f.Body().Blocks(), 0)
+}
+
 func TestImportingUser(t *testing.T) {
 	qa.HTTPFixturesApply(t,
 		[]qa.HTTPFixture{
@@ -919,6 +1327,42 @@ func TestImportingUser(t *testing.T) {
 		})
 }
 
+func TestImportingClusterPolicies_List(t *testing.T) {
+	qa.HTTPFixturesApply(t,
+		[]qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/list",
+				Response: compute.ClusterPolicyList{
+					Policies: []compute.ClusterPolicy{
+						{
+							PolicyID: "123",
+							Name:     "Team A",
+						},
+						{
+							PolicyID: "456",
+							Name:     "Team B",
+						},
+					},
+					TotalCount: 2,
+				},
+			},
+		}, func(ctx context.Context, client *common.DatabricksClient) {
+			ic := newImportContext(client)
+			ic.match = "team a"
+			err := resourcesMap["databricks_cluster_policy"].List(ic)
+			assert.NoError(t, err)
+			assert.True(t, ic.Has(&resource{
+				Resource: "databricks_cluster_policy",
+				ID:       "123",
+			}))
+			assert.False(t, ic.Has(&resource{
+				Resource: "databricks_cluster_policy",
+				ID:       "456",
+			}))
+		})
+}
+
 func TestEitherString(t *testing.T) {
 	assert.Equal(t, "a", eitherString("a", nil))
 	assert.Equal(t, "a", eitherString(nil, "a"))
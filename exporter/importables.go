@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/access"
+	"github.com/databrickslabs/terraform-provider-databricks/catalog"
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/compute"
 	"github.com/databrickslabs/terraform-provider-databricks/workspace"
@@ -134,6 +135,7 @@ var resourcesMap map[string]importable = map[string]importable{
 			if err != nil {
 				return err
 			}
+			ic.warnNotIncremental("Clusters")
 			lastActiveMs := ic.lastActiveDays * 24 * 60 * 60 * 1000
 			for offset, c := range clusters {
 				if c.ClusterSource == "JOB" {
@@ -168,6 +170,12 @@ var resourcesMap map[string]importable = map[string]importable{
 			if err := ic.importCluster(&c); err != nil {
 				return err
 			}
+			if c.Autoscale != nil {
+				// num_workers conflicts with autoscale, but is still reported by API
+				if err := r.Data.Set("num_workers", 0); err != nil {
+					return err
+				}
+			}
 			if ic.meAdmin {
 				ic.Emit(&resource{
 					Resource: "databricks_permissions",
@@ -252,6 +260,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		},
 		List: func(ic *importContext) error {
 			a := compute.NewJobsAPI(ic.Context, ic.Client)
+			ic.warnNotIncremental("Jobs")
 			nowSeconds := time.Now().Unix()
 			starterAfter := (nowSeconds - (ic.lastActiveDays * 24 * 60 * 60)) * 1000
 			if l, err := a.List(); err == nil {
@@ -297,6 +306,24 @@ var resourcesMap map[string]importable = map[string]importable{
 		Name: func(d *schema.ResourceData) string {
 			return d.Get("name").(string)
 		},
+		List: func(ic *importContext) error {
+			policies, err := compute.NewClusterPoliciesAPI(ic.Context, ic.Client).List()
+			if err != nil {
+				return err
+			}
+			ic.warnNotIncremental("Cluster policies")
+			for offset, policy := range policies {
+				if !ic.MatchesName(policy.Name) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_cluster_policy",
+					ID:       policy.PolicyID,
+				})
+				log.Printf("[INFO] Scanned %d of %d cluster policies", offset+1, len(policies))
+			}
+			return nil
+		},
 		Import: func(ic *importContext, r *resource) error {
 			ic.Emit(&resource{
 				Resource: "databricks_permissions",
@@ -813,6 +840,13 @@ var resourcesMap map[string]importable = map[string]importable{
 				return err
 			}
 			for offset, gis := range globalInitScripts {
+				if !ic.MatchesName(gis.Name) {
+					continue
+				}
+				if !ic.isUpdatedSince(gis.UpdatedAt) {
+					log.Printf("[DEBUG] Global init script %s wasn't updated since %s", gis.Name, ic.updatedSince)
+					continue
+				}
 				ic.Emit(&resource{
 					Resource: "databricks_global_init_script",
 					ID:       gis.ScriptID,
@@ -856,4 +890,47 @@ var resourcesMap map[string]importable = map[string]importable{
 			return nil
 		},
 	},
+	"databricks_function": {
+		Service: "uc",
+		Name: func(d *schema.ResourceData) string {
+			return strings.ReplaceAll(d.Id(), ".", "_")
+		},
+		List: func(ic *importContext) error {
+			catalogsAPI := catalog.NewCatalogsAPI(ic.Context, ic.Client)
+			catalogs, err := catalogsAPI.List()
+			if err != nil {
+				return err
+			}
+			functionsAPI := catalog.NewFunctionsAPI(ic.Context, ic.Client)
+			for _, c := range catalogs {
+				if c.Name == "system" {
+					continue
+				}
+				schemas, err := catalogsAPI.ListSchemas(c.Name)
+				if err != nil {
+					return err
+				}
+				for _, s := range schemas {
+					if s.Name == "information_schema" {
+						continue
+					}
+					functions, err := functionsAPI.List(c.Name, s.Name)
+					if err != nil {
+						return err
+					}
+					for _, f := range functions {
+						if !ic.MatchesName(f.FullName) {
+							continue
+						}
+						ic.Emit(&resource{
+							Resource: "databricks_function",
+							ID:       f.FullName,
+						})
+					}
+					log.Printf("[INFO] Scanned %d functions in %s.%s", len(functions), c.Name, s.Name)
+				}
+			}
+			return nil
+		},
+	},
 }
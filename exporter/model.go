@@ -3,9 +3,12 @@ package exporter
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
 )
 
 type regexFix struct {
@@ -87,13 +90,34 @@ func (r *resource) String() string {
 }
 
 func (r *resource) ImportCommand(ic *importContext) string {
-	m := ""
-	if ic.Module != "" {
-		m = ic.Module + "."
+	m := ic.resourceModule(r)
+	if m != "" {
+		m += "."
 	}
 	return fmt.Sprintf(`terraform import %s%s.%s "%s"`, m, r.Resource, r.Name, r.ID)
 }
 
+// ImportBlock appends import block, that is supported since Terraform 1.5
+func (r *resource) ImportBlock(ic *importContext, body *hclwrite.Body) {
+	var address []string
+	if m := ic.resourceModule(r); m != "" {
+		address = strings.Split(m, ".")
+		if address[0] != "module" {
+			// `-module=data_platform` is used in import.sh as is, but import block requires full address
+			address = append([]string{"module"}, address...)
+		}
+	}
+	address = append(address, r.Resource, r.Name)
+	to := hcl.Traversal{hcl.TraverseRoot{Name: address[0]}}
+	for _, name := range address[1:] {
+		to = append(to, hcl.TraverseAttr{Name: name})
+	}
+	b := body.AppendNewBlock("import", []string{}).Body()
+	b.SetAttributeTraversal("to", to)
+	b.SetAttributeValue("id", cty.StringVal(r.ID))
+	body.AppendNewline()
+}
+
 type importedResources []*resource
 
 func (a importedResources) Len() int {
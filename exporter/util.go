@@ -62,6 +62,8 @@ func (ic *importContext) importLibraries(d *schema.ResourceData, s map[string]*s
 }
 
 func (ic *importContext) cacheGroups() error {
+	ic.groupsMutex.Lock()
+	defer ic.groupsMutex.Unlock()
 	if len(ic.allGroups) == 0 {
 		log.Printf("[INFO] Caching groups in memory ...")
 		groupsAPI := identity.NewGroupsAPI(ic.Context, ic.Client)
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -33,7 +34,7 @@ func DataSourceCurrentUser() *schema.Resource {
 			usersAPI := NewUsersAPI(ctx, m)
 			me, err := usersAPI.Me()
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			d.Set("user_name", me.UserName)
 			d.Set("home", fmt.Sprintf("/Users/%s", me.UserName))
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// DataSourceGroup returns information about group specified by display name
+// DataSourceGroup returns information about group specified by display name or external id
 func DataSourceGroup() *schema.Resource {
 	type entity struct {
-		DisplayName      string   `json:"display_name"`
+		DisplayName      string   `json:"display_name,omitempty" tf:"computed"`
+		ExternalID       string   `json:"external_id,omitempty" tf:"computed"`
 		Recursive        bool     `json:"recursive,omitempty"`
 		Members          []string `json:"members,omitempty" tf:"slice_set,computed"`
 		Groups           []string `json:"groups,omitempty" tf:"slice_set,computed"`
@@ -25,6 +26,8 @@ func DataSourceGroup() *schema.Resource {
 		s map[string]*schema.Schema) map[string]*schema.Schema {
 		// nolint once SDKv2 has Diagnostics-returning validators, change
 		s["display_name"].ValidateFunc = validation.StringIsNotEmpty
+		s["display_name"].ExactlyOneOf = []string{"display_name", "external_id"}
+		s["external_id"].ValidateFunc = validation.StringIsNotEmpty
 		s["recursive"].Default = true
 		addEntitlementsToSchema(&s)
 		return s
@@ -36,17 +39,26 @@ func DataSourceGroup() *schema.Resource {
 			var this entity
 			err := common.DataToStructPointer(d, s, &this)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			groupsAPI := NewGroupsAPI(ctx, m)
-			groupList, err := groupsAPI.Filter(fmt.Sprintf("displayName eq '%s'", this.DisplayName))
+			filter := fmt.Sprintf("displayName eq '%s'", this.DisplayName)
+			if this.ExternalID != "" {
+				filter = fmt.Sprintf("externalId eq '%s'", this.ExternalID)
+			}
+			groupList, err := groupsAPI.Filter(filter)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			if len(groupList.Resources) == 0 {
-				return diag.FromErr(fmt.Errorf("cannot find group %s", this.DisplayName))
+				if this.ExternalID != "" {
+					return common.DiagnosticsFromErr(fmt.Errorf("cannot find group with external id %s", this.ExternalID))
+				}
+				return common.DiagnosticsFromErr(fmt.Errorf("cannot find group %s", this.DisplayName))
 			}
 			d.SetId(groupList.Resources[0].ID)
+			this.DisplayName = groupList.Resources[0].DisplayName
+			this.ExternalID = groupList.Resources[0].ExternalID
 			queue := []ScimGroup{groupList.Resources[0]}
 			for len(queue) > 0 {
 				current := queue[0]
@@ -64,7 +76,7 @@ func DataSourceGroup() *schema.Resource {
 					if this.Recursive {
 						childGroup, err := groupsAPI.Read(x.Value)
 						if err != nil {
-							return diag.FromErr(err)
+							return common.DiagnosticsFromErr(err)
 						}
 						queue = append(queue, childGroup)
 					}
@@ -75,7 +87,7 @@ func DataSourceGroup() *schema.Resource {
 			sort.Strings(this.InstanceProfiles)
 			err = common.StructToData(this, s, d)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			return nil
 		},
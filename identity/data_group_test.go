@@ -90,3 +90,59 @@ func TestDataSourceGroup(t *testing.T) {
 	assert.Equal(t, true, d.Get("allow_instance_pool_create"))
 	assert.Equal(t, true, d.Get("allow_cluster_create"))
 }
+
+func TestDataSourceGroup_ExternalID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups?filter=externalId%20eq%20%278a6d3c4f%27",
+				Response: GroupList{
+					Resources: []ScimGroup{
+						{
+							DisplayName: "Data Scientists",
+							ExternalID:  "8a6d3c4f",
+							ID:          "eerste",
+							Entitlements: []ComplexValue{
+								{
+									Value: "workspace-access",
+								},
+								{
+									Value: "databricks-sql-access",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceGroup(),
+		ID:          ".",
+		HCL:         `external_id = "8a6d3c4f"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "eerste", d.Id())
+	assert.Equal(t, "Data Scientists", d.Get("display_name"))
+	assert.Equal(t, true, d.Get("workspace_access"))
+	assert.Equal(t, true, d.Get("allow_sql_analytics_access"))
+	assert.Equal(t, false, d.Get("allow_cluster_create"))
+}
+
+func TestDataSourceGroup_ExternalIDNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups?filter=externalId%20eq%20%278a6d3c4f%27",
+				Response: GroupList{},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceGroup(),
+		ID:          ".",
+		HCL:         `external_id = "8a6d3c4f"`,
+	}.ExpectError(t, "cannot find group with external id 8a6d3c4f")
+}
@@ -0,0 +1,54 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type tokensData struct {
+	Tokens []ManagedTokenInfo `json:"tokens,omitempty" tf:"computed"`
+	IDs    []string           `json:"ids,omitempty" tf:"computed"`
+}
+
+// DataSourceTokens lists tokens of all users in the workspace
+func DataSourceTokens() *schema.Resource {
+	s := common.StructToSchema(TokenFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		for k, v := range common.StructToSchema(tokensData{}, func(
+			m map[string]*schema.Schema) map[string]*schema.Schema {
+			return m
+		}) {
+			s[k] = v
+		}
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData,
+			m interface{}) diag.Diagnostics {
+			var filter TokenFilter
+			err := common.DataToStructPointer(d, s, &filter)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			var data tokensData
+			data.Tokens, err = NewTokenManagementAPI(ctx, m).List(filter)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			data.IDs = []string{}
+			for _, ti := range data.Tokens {
+				data.IDs = append(data.IDs, ti.TokenID)
+			}
+			err = common.StructToData(data, s, d)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			d.SetId(".")
+			return nil
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceTokens(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?created_by_username=me%40example.com",
+				Response: ManagedTokenList{
+					TokenInfos: []ManagedTokenInfo{
+						{
+							TokenID:           "abc",
+							Comment:           "ci pipeline",
+							CreationTime:      1000,
+							ExpiryTime:        -1,
+							CreatedByID:       123,
+							CreatedByUsername: "me@example.com",
+							OwnerID:           123,
+						},
+						{
+							TokenID:           "def",
+							Comment:           "laptop",
+							CreationTime:      2000,
+							ExpiryTime:        3000,
+							CreatedByID:       123,
+							CreatedByUsername: "me@example.com",
+							OwnerID:           123,
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceTokens(),
+		HCL:         `created_by_username = "me@example.com"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          ".",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, []interface{}{"abc", "def"}, d.Get("ids"))
+	assert.Equal(t, "ci pipeline", d.Get("tokens.0.comment"))
+	assert.Equal(t, -1, d.Get("tokens.0.expiry_time"))
+}
+
+func TestDataSourceTokens_CommentAndExpiry(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?",
+				Response: ManagedTokenList{
+					TokenInfos: []ManagedTokenInfo{
+						{
+							TokenID:    "abc",
+							Comment:    "ci pipeline",
+							ExpiryTime: -1,
+						},
+						{
+							TokenID:    "def",
+							Comment:    "ci pipeline",
+							ExpiryTime: 3000,
+						},
+						{
+							TokenID:    "ghi",
+							Comment:    "laptop",
+							ExpiryTime: -1,
+						},
+					},
+				},
+			},
+		},
+		Resource: DataSourceTokens(),
+		HCL: `
+		comment_contains = "ci"
+		without_expiry = true
+		`,
+		Read:        true,
+		NonWritable: true,
+		ID:          ".",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, []interface{}{"abc"}, d.Get("ids"))
+}
+
+func TestDataSourceTokens_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?",
+				Status:   403,
+				Response: map[string]string{
+					"error_code": "PERMISSION_DENIED",
+					"message":    "Only admins can access token management",
+				},
+			},
+		},
+		Resource:    DataSourceTokens(),
+		Read:        true,
+		NonWritable: true,
+		ID:          ".",
+	}.ExpectError(t, "Only admins can access token management")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,10 +18,23 @@ func getUser(usersAPI UsersAPI, id, name string) (user ScimUser, err error) {
 	if err != nil {
 		return
 	}
+	lowerName := strings.ToLower(name)
+	if len(userList) == 0 && lowerName != name {
+		// SCIM stores user names in lower case, so `User@Corp.com` has to be searched as `user@corp.com`
+		userList, err = usersAPI.Filter(fmt.Sprintf("userName eq '%s'", lowerName))
+		if err != nil {
+			return
+		}
+	}
 	if len(userList) == 0 {
 		err = fmt.Errorf("cannot find user %s", name)
 		return
 	}
+	for _, u := range userList {
+		if strings.EqualFold(u.UserName, name) {
+			return u, nil
+		}
+	}
 	user = userList[0]
 	return
 }
@@ -56,7 +70,7 @@ func DataSourceUser() *schema.Resource {
 			usersAPI := NewUsersAPI(ctx, m)
 			user, err := getUser(usersAPI, d.Get("user_id").(string), d.Get("user_name").(string))
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			d.Set("user_name", user.UserName)
 			d.Set("display_name", user.DisplayName)
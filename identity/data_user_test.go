@@ -76,3 +76,36 @@ func TestDataSourceUserGerUser(t *testing.T) {
 		assert.EqualError(t, err, "cannot find user empty_search")
 	})
 }
+
+func TestDataSourceUser_CaseInsensitive(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27Mr.Test%40Example.com%27",
+				Response: UserList{},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27mr.test%40example.com%27",
+				Response: UserList{
+					Resources: []ScimUser{
+						{
+							ID:       "123",
+							UserName: "mr.test@example.com",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceUser(),
+		ID:          ".",
+		HCL:         `user_name = "Mr.Test@Example.com"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "123", d.Id())
+	assert.Equal(t, "mr.test@example.com", d.Get("user_name"))
+	assert.Equal(t, "/Users/mr.test@example.com", d.Get("home"))
+}
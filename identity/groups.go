@@ -2,12 +2,58 @@ package identity
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+// groupConflictTimeout is how long we keep retrying mutations of a group,
+// that were rejected because of concurrent modification
+var groupConflictTimeout = 5 * time.Minute
+
+var (
+	groupLocksMutex sync.Mutex
+	groupLocks      = map[string]*sync.Mutex{}
+)
+
+// lockGroup serializes mutations of the same group within provider process,
+// as many members or roles of one group are usually applied concurrently
+func lockGroup(groupID string) func() {
+	groupLocksMutex.Lock()
+	m, ok := groupLocks[groupID]
+	if !ok {
+		m = &sync.Mutex{}
+		groupLocks[groupID] = m
+	}
+	groupLocksMutex.Unlock()
+	m.Lock()
+	return m.Unlock
+}
+
+// mutateGroup runs SCIM mutation under per-group lock and retries it
+// on version conflicts, caused by modifications from outside of this process
+func (a GroupsAPI) mutateGroup(groupID string, mutate func() error) error {
+	defer lockGroup(groupID)()
+	return resource.RetryContext(a.context, groupConflictTimeout, func() *resource.RetryError {
+		err := mutate()
+		var apiErr common.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+			log.Printf("[INFO] Group %s was concurrently modified, retrying: %s", groupID, err)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
 // NewGroupsAPI creates GroupsAPI instance from provider meta
 func NewGroupsAPI(ctx context.Context, m interface{}) GroupsAPI {
 	return GroupsAPI{
@@ -50,24 +96,28 @@ func (a GroupsAPI) Filter(filter string) (GroupList, error) {
 }
 
 func (a GroupsAPI) Patch(groupID string, r patchRequest) error {
-	return a.client.Scim(a.context, http.MethodPatch, fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID), r, nil)
+	return a.mutateGroup(groupID, func() error {
+		return a.client.Scim(a.context, http.MethodPatch, fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID), r, nil)
+	})
 }
 
 func (a GroupsAPI) UpdateNameAndEntitlements(groupID string, name string, e entitlements) error {
-	g, err := a.Read(groupID)
-	if err != nil {
-		return err
-	}
-	return a.client.Scim(a.context, http.MethodPut,
-		fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID),
-		ScimGroup{
-			DisplayName:  name,
-			Entitlements: e,
-			Groups:       g.Groups,
-			Roles:        g.Roles,
-			Members:      g.Members,
-			Schemas:      []URN{GroupSchema},
-		}, nil)
+	return a.mutateGroup(groupID, func() error {
+		g, err := a.Read(groupID)
+		if err != nil {
+			return err
+		}
+		return a.client.Scim(a.context, http.MethodPut,
+			fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID),
+			ScimGroup{
+				DisplayName:  name,
+				Entitlements: e,
+				Groups:       g.Groups,
+				Roles:        g.Roles,
+				Members:      g.Members,
+				Schemas:      []URN{GroupSchema},
+			}, nil)
+	})
 }
 
 // Delete deletes a group given a group id
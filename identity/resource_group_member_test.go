@@ -1,7 +1,10 @@
 package identity
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
@@ -46,6 +49,67 @@ func TestResourceGroupMemberCreate(t *testing.T) {
 	assert.Equal(t, "abc|bcd", d.Id())
 }
 
+func TestResourceGroupMemberCreate_RetriesOnConflict(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc",
+				Response: common.APIErrorBody{
+					ScimDetail: "Group was modified concurrently",
+					ScimStatus: "409",
+				},
+				Status: 409,
+			},
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: scimPatchRequest("add", "members", "bcd"),
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc",
+				Response: ScimGroup{
+					Members: []ComplexValue{
+						{
+							Value: "bcd",
+						},
+					},
+					ID: "abc",
+				},
+			},
+		},
+		Resource: ResourceGroupMember(),
+		State: map[string]interface{}{
+			"group_id":  "abc",
+			"member_id": "bcd",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc|bcd", d.Id())
+}
+
+func TestLockGroupSerializesMutations(t *testing.T) {
+	var wg sync.WaitGroup
+	var active, maxActive int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer lockGroup("abc")()
+			current := atomic.AddInt32(&active, 1)
+			if current > atomic.LoadInt32(&maxActive) {
+				atomic.StoreInt32(&maxActive, current)
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&active, -1)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), maxActive)
+}
+
 func TestResourceGroupMemberCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -19,7 +19,9 @@ import (
 
 // InstanceProfileInfo contains the ARN for aws instance profiles
 type InstanceProfileInfo struct {
-	InstanceProfileArn string `json:"instance_profile_arn,omitempty"`
+	InstanceProfileArn    string `json:"instance_profile_arn,omitempty"`
+	IamRoleArn            string `json:"iam_role_arn,omitempty"`
+	IsMetaInstanceProfile bool   `json:"is_meta_instance_profile,omitempty"`
 }
 
 // InstanceProfileList ...
@@ -41,28 +43,49 @@ type InstanceProfilesAPI struct {
 	context context.Context
 }
 
-// Create creates an instance profile record on Databricks
-func (a InstanceProfilesAPI) Create(instanceProfileARN string) error {
-	return a.client.Post(a.context, "/instance-profiles/add", map[string]interface{}{
-		"instance_profile_arn": instanceProfileARN,
-		"skip_validation":      false,
+// Create creates an instance profile record on Databricks. Validation launches a cluster
+// with the instance profile, that might not be possible in restricted accounts.
+func (a InstanceProfilesAPI) Create(ip InstanceProfileInfo, skipValidation bool) error {
+	request := map[string]interface{}{
+		"instance_profile_arn": ip.InstanceProfileArn,
+		"skip_validation":      skipValidation,
+	}
+	if ip.IamRoleArn != "" {
+		request["iam_role_arn"] = ip.IamRoleArn
+	}
+	if ip.IsMetaInstanceProfile {
+		request["is_meta_instance_profile"] = true
+	}
+	return a.client.Post(a.context, "/instance-profiles/add", request, nil)
+}
+
+// Update changes IAM role and meta flag of the instance profile
+func (a InstanceProfilesAPI) Update(ip InstanceProfileInfo) error {
+	return a.client.Post(a.context, "/instance-profiles/edit", map[string]interface{}{
+		"instance_profile_arn":     ip.InstanceProfileArn,
+		"iam_role_arn":             ip.IamRoleArn,
+		"is_meta_instance_profile": ip.IsMetaInstanceProfile,
 	}, nil)
 }
 
 // Read returns the ARN back if it exists on the Databricks workspace
 func (a InstanceProfilesAPI) Read(instanceProfileARN string) (string, error) {
-	var response string
+	profile, err := a.Get(instanceProfileARN)
+	return profile.InstanceProfileArn, err
+}
+
+// Get returns the instance profile, if it exists on the Databricks workspace
+func (a InstanceProfilesAPI) Get(instanceProfileARN string) (InstanceProfileInfo, error) {
 	instanceProfiles, err := a.List()
 	if err != nil {
-		return response, err
+		return InstanceProfileInfo{}, err
 	}
 	for _, profile := range instanceProfiles {
 		if profile.InstanceProfileArn == instanceProfileARN {
-			response = profile.InstanceProfileArn
-			return response, nil
+			return profile, nil
 		}
 	}
-	return response, common.APIError{
+	return InstanceProfileInfo{}, common.APIError{
 		ErrorCode: "NOT_FOUND",
 		Message: fmt.Sprintf("Instance profile with name: %s not found in "+
 			"list of instance profiles in the workspace!", instanceProfileARN),
@@ -146,28 +169,104 @@ func ResourceInstanceProfile() *schema.Resource {
 
 				ValidateDiagFunc: ValidInstanceProfile,
 			},
+			"iam_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				// platform derives the role from instance profile, when it's not set
+				Computed: true,
+
+				ValidateDiagFunc: validIamRole,
+			},
+			"is_meta_instance_profile": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"skip_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				// validation happens only on creation
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			profile, err := NewInstanceProfilesAPI(ctx, c).Read(d.Id())
+			profile, err := NewInstanceProfilesAPI(ctx, c).Get(d.Id())
 			if err != nil {
 				return err
 			}
-			return d.Set("instance_profile_arn", profile)
+			if err = d.Set("instance_profile_arn", profile.InstanceProfileArn); err != nil {
+				return err
+			}
+			if err = d.Set("iam_role_arn", profile.IamRoleArn); err != nil {
+				return err
+			}
+			return d.Set("is_meta_instance_profile", profile.IsMetaInstanceProfile)
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			ipa := d.Get("instance_profile_arn").(string)
-			if err := NewInstanceProfilesAPI(ctx, c).Create(ipa); err != nil {
+			ip := instanceProfileFromData(d)
+			err := NewInstanceProfilesAPI(ctx, c).Create(ip, d.Get("skip_validation").(bool))
+			if err != nil {
 				return err
 			}
-			d.SetId(ipa)
+			d.SetId(ip.InstanceProfileArn)
 			return nil
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if !d.HasChanges("iam_role_arn", "is_meta_instance_profile") {
+				return nil
+			}
+			return NewInstanceProfilesAPI(ctx, c).Update(instanceProfileFromData(d))
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewInstanceProfilesAPI(ctx, c).Delete(d.Id())
 		},
 	}.ToResource()
 }
 
+func instanceProfileFromData(d *schema.ResourceData) InstanceProfileInfo {
+	return InstanceProfileInfo{
+		InstanceProfileArn:    d.Get("instance_profile_arn").(string),
+		IamRoleArn:            d.Get("iam_role_arn").(string),
+		IsMetaInstanceProfile: d.Get("is_meta_instance_profile").(bool),
+	}
+}
+
+// validIamRole validates if it's valid IAM role ARN, that is required for
+// meta instance profiles and serverless SQL, when role name differs from profile name
+func validIamRole(v interface{}, c cty.Path) diag.Diagnostics {
+	s, ok := v.(string)
+	if !ok {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				AttributePath: c,
+				Summary:       "Invalid ARN",
+				Detail:        "Not a string",
+			},
+		}
+	}
+	roleArn, err := arn.Parse(s)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				AttributePath: c,
+				Summary:       "Invalid ARN",
+				Detail:        err.Error(),
+			},
+		}
+	}
+	if roleArn.Service != "iam" || !strings.HasPrefix(roleArn.Resource, "role/") {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				AttributePath: c,
+				Summary:       "Invalid ARN",
+				Detail:        fmt.Sprintf("Not an IAM role ARN: %s", v),
+			},
+		}
+	}
+	return nil
+}
+
 // ValidInstanceProfile validate if it's valid instance profile ARN
 func ValidInstanceProfile(v interface{}, c cty.Path) diag.Diagnostics {
 	s, ok := v.(string)
@@ -43,6 +43,98 @@ func TestResourceInstanceProfileCreate(t *testing.T) {
 	assert.Equal(t, "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile", d.Id())
 }
 
+func TestResourceInstanceProfileCreate_MetaSkipValidation(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/add",
+				ExpectedRequest: map[string]interface{}{
+					"instance_profile_arn":     "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+					"iam_role_arn":             "arn:aws:iam::999999999999:role/my-fake-role",
+					"is_meta_instance_profile": true,
+					"skip_validation":          true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-profiles/list",
+				Response: InstanceProfileList{
+					InstanceProfiles: []InstanceProfileInfo{
+						{
+							InstanceProfileArn:    "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+							IamRoleArn:            "arn:aws:iam::999999999999:role/my-fake-role",
+							IsMetaInstanceProfile: true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceInstanceProfile(),
+		HCL: `instance_profile_arn = "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile"
+		iam_role_arn = "arn:aws:iam::999999999999:role/my-fake-role"
+		is_meta_instance_profile = true
+		skip_validation = true`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile", d.Id())
+	assert.Equal(t, "arn:aws:iam::999999999999:role/my-fake-role", d.Get("iam_role_arn"))
+	assert.Equal(t, true, d.Get("is_meta_instance_profile"))
+	assert.Equal(t, true, d.Get("skip_validation"))
+}
+
+func TestResourceInstanceProfileCreate_InvalidRole(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceInstanceProfile(),
+		HCL: `instance_profile_arn = "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile"
+		iam_role_arn = "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile"`,
+		Create: true,
+	}.Apply(t)
+	assert.EqualError(t, err, "invalid config supplied. [iam_role_arn] Invalid ARN")
+}
+
+func TestResourceInstanceProfileUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/edit",
+				ExpectedRequest: map[string]interface{}{
+					"instance_profile_arn":     "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+					"iam_role_arn":             "arn:aws:iam::999999999999:role/my-fake-role",
+					"is_meta_instance_profile": true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-profiles/list",
+				Response: InstanceProfileList{
+					InstanceProfiles: []InstanceProfileInfo{
+						{
+							InstanceProfileArn:    "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+							IamRoleArn:            "arn:aws:iam::999999999999:role/my-fake-role",
+							IsMetaInstanceProfile: true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceInstanceProfile(),
+		InstanceState: map[string]string{
+			"instance_profile_arn": "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+			"iam_role_arn":         "arn:aws:iam::999999999999:role/my-fake-instance-profile",
+		},
+		HCL: `instance_profile_arn = "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile"
+		iam_role_arn = "arn:aws:iam::999999999999:role/my-fake-role"
+		is_meta_instance_profile = true`,
+		ID:     "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+		Update: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "arn:aws:iam::999999999999:role/my-fake-role", d.Get("iam_role_arn"))
+}
+
 func TestResourceInstanceProfileCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -186,7 +278,7 @@ func TestAwsAccInstanceProfiles(t *testing.T) {
 	ctx := context.WithValue(context.Background(), common.Current, t.Name())
 	instanceProfilesAPI := NewInstanceProfilesAPI(ctx, client)
 	instanceProfilesAPI.Synchronized(arn, func() bool {
-		err := instanceProfilesAPI.Create(arn)
+		err := instanceProfilesAPI.Create(InstanceProfileInfo{InstanceProfileArn: arn}, false)
 		if err != nil {
 			return false
 		}
@@ -3,6 +3,7 @@ package identity
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
@@ -50,6 +51,12 @@ func (a ServicePrincipalsAPI) Update(servicePrincipalID string, updateRequest Sc
 		updateRequest, nil)
 }
 
+// Patch updates resource-friendly entity
+func (a ServicePrincipalsAPI) Patch(servicePrincipalID string, r patchRequest) error {
+	return a.client.Scim(a.context, http.MethodPatch,
+		fmt.Sprintf("/preview/scim/v2/ServicePrincipals/%v", servicePrincipalID), r, nil)
+}
+
 // Delete will delete the servicePrincipal given the servicePrincipal id
 func (a ServicePrincipalsAPI) Delete(servicePrincipalID string) error {
 	servicePrincipalPath := fmt.Sprintf("/preview/scim/v2/ServicePrincipals/%v", servicePrincipalID)
@@ -84,16 +91,15 @@ func ResourceServicePrincipal() *schema.Resource {
 	}
 	return common.Resource{
 		Schema: servicePrincipalSchema,
-		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
 			var sp entity
 			if err := common.DiffToStructPointer(d, servicePrincipalSchema, &sp); err != nil {
 				return err
 			}
-			client := c.(*common.DatabricksClient)
-			if client.IsAzure() && sp.ApplicationID == "" {
+			if c.IsAzure() && sp.ApplicationID == "" {
 				return fmt.Errorf("application_id is required for service principals in Azure Databricks")
 			}
-			if client.IsAws() && sp.DisplayName == "" {
+			if c.IsAws() && sp.DisplayName == "" {
 				return fmt.Errorf("display_name is required for service principals in Databricks on AWS")
 			}
 			return nil
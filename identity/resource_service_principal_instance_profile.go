@@ -0,0 +1,35 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceServicePrincipalInstanceProfile binds service principal and instance profile
+func ResourceServicePrincipalInstanceProfile() *schema.Resource {
+	return common.NewPairID("service_principal_id", "instance_profile_id").Schema(func(
+		m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["instance_profile_id"].ValidateDiagFunc = ValidInstanceProfile
+		return m
+	}).BindResource(common.BindResource{
+		CreateContext: func(ctx context.Context, spID, roleARN string, c *common.DatabricksClient) error {
+			return NewServicePrincipalsAPI(ctx, c).Patch(spID, scimPatchRequest("add", "roles", roleARN))
+		},
+		ReadContext: func(ctx context.Context, spID, roleARN string, c *common.DatabricksClient) error {
+			sp, err := NewServicePrincipalsAPI(ctx, c).read(spID)
+			hasRole := complexValues(sp.Roles).HasValue(roleARN)
+			if err == nil && !hasRole {
+				return common.NotFound("Service principal has no role")
+			}
+			return err
+		},
+		DeleteContext: func(ctx context.Context, spID, roleARN string, c *common.DatabricksClient) error {
+			return NewServicePrincipalsAPI(ctx, c).Patch(spID, scimPatchRequest(
+				"remove", fmt.Sprintf(`roles[value eq "%s"]`, roleARN), ""))
+		},
+	})
+}
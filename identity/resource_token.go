@@ -3,6 +3,7 @@ package identity
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"time"
 
@@ -96,6 +97,15 @@ func (a TokensAPI) Delete(tokenID string) error {
 	}, nil)
 }
 
+// shouldRotate tells if token expires within given amount of seconds. Expiry time is in milliseconds.
+func shouldRotate(tokenInfo TokenInfo, rotateBeforeExpiry int) bool {
+	if rotateBeforeExpiry <= 0 || tokenInfo.ExpiryTime <= 0 {
+		return false
+	}
+	deadline := time.Now().Add(time.Duration(rotateBeforeExpiry) * time.Second)
+	return deadline.UnixNano()/int64(time.Millisecond) >= tokenInfo.ExpiryTime
+}
+
 // ResourceToken refreshes token in case it's expired
 func ResourceToken() *schema.Resource {
 	s := map[string]*schema.Schema{
@@ -109,6 +119,16 @@ func ResourceToken() *schema.Resource {
 			Optional: true,
 			ForceNew: true,
 		},
+		"rotate_before_expiry": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"keepers": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
 		"token_value": {
 			Type:      schema.TypeString,
 			Computed:  true,
@@ -132,6 +152,21 @@ func ResourceToken() *schema.Resource {
 	}
 	return common.Resource{
 		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			if d.Id() == "" {
+				return nil
+			}
+			tokenInfo := TokenInfo{ExpiryTime: int64(d.Get("expiry_time").(int))}
+			if !shouldRotate(tokenInfo, d.Get("rotate_before_expiry").(int)) {
+				return nil
+			}
+			// replacement revokes the old token through Delete
+			log.Printf("[INFO] Token %s expires soon and will be re-created", d.Id())
+			if err := d.SetNewComputed("token_value"); err != nil {
+				return err
+			}
+			return d.ForceNew("token_value")
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			comment := d.Get("comment").(string)
 			lifeTimeSeconds := d.Get("lifetime_seconds").(int)
@@ -150,6 +185,10 @@ func ResourceToken() *schema.Resource {
 			}
 			return common.StructToData(tokenInfo, s, d)
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// only rotate_before_expiry is updatable and it's not sent to the API
+			return nil
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewTokensAPI(ctx, c).Delete(d.Id())
 		},
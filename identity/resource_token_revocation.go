@@ -0,0 +1,113 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withoutCallerTokens drops tokens of the caller, when provider authenticates with
+// personal access token, so that revocation never locks provider itself out
+func withoutCallerTokens(ctx context.Context, c *common.DatabricksClient,
+	tokens []ManagedTokenInfo) ([]ManagedTokenInfo, error) {
+	if c.Token == "" || len(tokens) == 0 {
+		return tokens, nil
+	}
+	own, err := NewTokensAPI(ctx, c).List()
+	if err != nil {
+		return nil, fmt.Errorf("cannot list tokens of the caller: %w", err)
+	}
+	skip := map[string]bool{}
+	for _, ti := range own {
+		skip[ti.TokenID] = true
+	}
+	filtered := []ManagedTokenInfo{}
+	for _, ti := range tokens {
+		if skip[ti.TokenID] {
+			log.Printf("[WARN] Not revoking token %s, as provider might authenticate with it", ti.TokenID)
+			continue
+		}
+		filtered = append(filtered, ti)
+	}
+	return filtered, nil
+}
+
+// ResourceTokenRevocation revokes workspace tokens, that match given criteria. Tokens, that
+// match criteria later on, are revoked on the next apply.
+func ResourceTokenRevocation() *schema.Resource {
+	criteria := []string{"created_by_id", "created_by_username", "comment_contains",
+		"older_than_days", "without_expiry"}
+	s := common.StructToSchema(TokenFilter{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		for _, k := range criteria {
+			s[k].ForceNew = true
+			// revoking all tokens of the workspace is almost never intended
+			s[k].AtLeastOneOf = criteria
+		}
+		s["revoked_token_ids"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var filter TokenFilter
+			if err := common.DataToStructPointer(d, s, &filter); err != nil {
+				return err
+			}
+			tokenManagementAPI := NewTokenManagementAPI(ctx, c)
+			tokens, err := tokenManagementAPI.List(filter)
+			if err != nil {
+				return err
+			}
+			tokens, err = withoutCallerTokens(ctx, c, tokens)
+			if err != nil {
+				return err
+			}
+			revoked := []string{}
+			for _, ti := range tokens {
+				log.Printf("[INFO] Revoking token %s of %s", ti.TokenID, ti.CreatedByUsername)
+				if err = tokenManagementAPI.Delete(ti.TokenID); err != nil {
+					return fmt.Errorf("cannot revoke token %s: %w", ti.TokenID, err)
+				}
+				revoked = append(revoked, ti.TokenID)
+			}
+			d.SetId(fmt.Sprintf("%d", time.Now().Unix()))
+			return d.Set("revoked_token_ids", revoked)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if d.IsNewResource() {
+				return nil
+			}
+			var filter TokenFilter
+			if err := common.DataToStructPointer(d, s, &filter); err != nil {
+				return err
+			}
+			tokens, err := NewTokenManagementAPI(ctx, c).List(filter)
+			if err != nil {
+				return err
+			}
+			tokens, err = withoutCallerTokens(ctx, c, tokens)
+			if err != nil {
+				return err
+			}
+			if len(tokens) > 0 {
+				log.Printf("[INFO] %d new tokens match revocation criteria and will be revoked", len(tokens))
+				d.SetId("")
+			}
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// revoked tokens can't be restored
+			return nil
+		},
+	}.ToResource()
+}
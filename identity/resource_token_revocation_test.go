@@ -0,0 +1,275 @@
+package identity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceTokenRevocationCreate(t *testing.T) {
+	recent := time.Now().UnixNano() / int64(time.Millisecond)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/token/list",
+				Response:     TokenList{},
+				ReuseRequest: true,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?created_by_username=leaver%40example.com",
+				Response: ManagedTokenList{
+					TokenInfos: []ManagedTokenInfo{
+						{
+							TokenID:           "abc",
+							CreationTime:      1000,
+							CreatedByUsername: "leaver@example.com",
+						},
+						{
+							TokenID:           "def",
+							CreationTime:      recent,
+							CreatedByUsername: "leaver@example.com",
+						},
+					},
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/token-management/tokens/abc",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?created_by_username=leaver%40example.com",
+				Response: ManagedTokenList{
+					TokenInfos: []ManagedTokenInfo{
+						{
+							TokenID:           "def",
+							CreationTime:      recent,
+							CreatedByUsername: "leaver@example.com",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceTokenRevocation(),
+		Create:   true,
+		HCL: `
+		created_by_username = "leaver@example.com"
+		older_than_days = 90
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.NotEqual(t, "", d.Id())
+	assert.Equal(t, []interface{}{"abc"}, d.Get("revoked_token_ids"))
+}
+
+func TestResourceTokenRevocationCreate_SkipsCallerToken(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?",
+				Response: ManagedTokenList{
+					TokenInfos: []ManagedTokenInfo{
+						{
+							TokenID:    "abc",
+							ExpiryTime: -1,
+						},
+						{
+							TokenID:    "caller",
+							ExpiryTime: -1,
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/token/list",
+				ReuseRequest: true,
+				Response: TokenList{
+					TokenInfos: []TokenInfo{
+						{
+							TokenID:    "caller",
+							ExpiryTime: -1,
+						},
+					},
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/token-management/tokens/abc",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?",
+				Response: ManagedTokenList{
+					TokenInfos: []ManagedTokenInfo{
+						{
+							TokenID:    "caller",
+							ExpiryTime: -1,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceTokenRevocation(),
+		Create:   true,
+		HCL:      `without_expiry = true`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, []interface{}{"abc"}, d.Get("revoked_token_ids"))
+}
+
+func TestResourceTokenRevocationRead_OnlyCallerToken(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?",
+				Response: ManagedTokenList{
+					TokenInfos: []ManagedTokenInfo{
+						{
+							TokenID:    "caller",
+							ExpiryTime: -1,
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token/list",
+				Response: TokenList{
+					TokenInfos: []TokenInfo{
+						{
+							TokenID: "caller",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceTokenRevocation(),
+		Read:     true,
+		ID:       "1600000000",
+		HCL:      `without_expiry = true`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "1600000000", d.Id())
+}
+
+func TestResourceTokenRevocationCreate_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/token/list",
+				Response:     TokenList{},
+				ReuseRequest: true,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?",
+				Response: ManagedTokenList{
+					TokenInfos: []ManagedTokenInfo{
+						{
+							TokenID:    "abc",
+							ExpiryTime: -1,
+						},
+					},
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/token-management/tokens/abc",
+				Status:   403,
+				Response: map[string]string{
+					"error_code": "PERMISSION_DENIED",
+					"message":    "Only admins can revoke tokens",
+				},
+			},
+		},
+		Resource: ResourceTokenRevocation(),
+		Create:   true,
+		HCL:      `without_expiry = true`,
+	}.ExpectError(t, "cannot revoke token abc: Only admins can revoke tokens")
+}
+
+func TestResourceTokenRevocationCreate_NoCriteria(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceTokenRevocation(),
+		Create:   true,
+		State:    map[string]interface{}{},
+	}.Apply(t)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[without_expiry] Missing required argument")
+}
+
+func TestResourceTokenRevocationRead_NewTokens(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/token/list",
+				Response:     TokenList{},
+				ReuseRequest: true,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?",
+				Response: ManagedTokenList{
+					TokenInfos: []ManagedTokenInfo{
+						{
+							TokenID:    "ghi",
+							ExpiryTime: -1,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceTokenRevocation(),
+		Read:     true,
+		Removed:  true,
+		ID:       "1600000000",
+		HCL:      `without_expiry = true`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "", d.Id(), "resource has to be re-created")
+}
+
+func TestResourceTokenRevocationRead_NoNewTokens(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/token/list",
+				Response:     TokenList{},
+				ReuseRequest: true,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token-management/tokens?",
+				Response: ManagedTokenList{},
+			},
+		},
+		Resource: ResourceTokenRevocation(),
+		Read:     true,
+		ID:       "1600000000",
+		HCL:      `without_expiry = true`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "1600000000", d.Id())
+}
+
+func TestResourceTokenRevocationDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceTokenRevocation(),
+		Delete:   true,
+		ID:       "1600000000",
+		InstanceState: map[string]string{
+			"without_expiry": "true",
+		},
+	}.ApplyNoError(t)
+}
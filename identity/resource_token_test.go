@@ -2,6 +2,7 @@ package identity
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -9,7 +10,9 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResourceTokenRead(t *testing.T) {
@@ -292,3 +295,97 @@ func TestAccCreateToken_NoExpiration(t *testing.T) {
 	assert.NoError(t, err, err)
 	assert.True(t, len(tokenList) > 0, "Token list is empty")
 }
+
+func tokenDiff(t *testing.T, expiresIn time.Duration) *terraform.InstanceDiff {
+	expiry := time.Now().Add(expiresIn).UnixNano() / int64(time.Millisecond)
+	diff, err := ResourceToken().Diff(context.Background(), &terraform.InstanceState{
+		ID: "abc",
+		Attributes: map[string]string{
+			"id":                   "abc",
+			"token_id":             "abc",
+			"token_value":          "dapi...",
+			"expiry_time":          fmt.Sprintf("%d", expiry),
+			"rotate_before_expiry": "7200",
+		},
+	}, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"rotate_before_expiry": 7200,
+	}), nil)
+	require.NoError(t, err)
+	return diff
+}
+
+func TestResourceTokenDiff_Rotate(t *testing.T) {
+	diff := tokenDiff(t, time.Hour)
+	require.NotNil(t, diff)
+	assert.True(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["token_value"].NewComputed)
+}
+
+func TestResourceTokenDiff_NoRotate(t *testing.T) {
+	assert.Nil(t, tokenDiff(t, 24*time.Hour))
+}
+
+func TestResourceTokenRead_NoRotate(t *testing.T) {
+	expiry := time.Now().Add(24*time.Hour).UnixNano() / int64(time.Millisecond)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token/list",
+				Response: TokenList{
+					TokenInfos: []TokenInfo{
+						{
+							Comment:      "Hello, world!",
+							CreationTime: 10,
+							ExpiryTime:   expiry,
+							TokenID:      "abc",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceToken(),
+		InstanceState: map[string]string{
+			"rotate_before_expiry": "7200",
+		},
+		Read: true,
+		ID:   "abc",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, int(expiry), d.Get("expiry_time"))
+}
+
+func TestResourceTokenUpdate_RotateBeforeExpiry(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/token/list",
+				Response: TokenList{
+					TokenInfos: []TokenInfo{
+						{
+							Comment:      "Hello world!",
+							CreationTime: 10,
+							ExpiryTime:   0,
+							TokenID:      "abc",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceToken(),
+		InstanceState: map[string]string{
+			"comment":              "Hello world!",
+			"rotate_before_expiry": "3600",
+		},
+		State: map[string]interface{}{
+			"comment":              "Hello world!",
+			"rotate_before_expiry": 7200,
+		},
+		Update: true,
+		ID:     "abc",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 7200, d.Get("rotate_before_expiry"))
+}
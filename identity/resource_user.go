@@ -2,6 +2,7 @@ package identity
 
 import (
 	"context"
+	"strings"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
@@ -19,6 +20,11 @@ func ResourceUser() *schema.Resource {
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
 			addEntitlementsToSchema(&m)
 			m["user_name"].ForceNew = true
+			// SCIM stores user names in lower case, so that `User@Corp.com` from
+			// configuration is not shown as changed and doesn't re-create the user
+			m["user_name"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.EqualFold(old, new)
+			}
 			m["active"].Default = true
 			return m
 		})
@@ -1,12 +1,14 @@
 package identity
 
 import (
+	"context"
 	"testing"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResourceUserInstanceProfileCreate(t *testing.T) {
@@ -212,3 +214,23 @@ func TestResourceUserInstanceProfileDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abc|arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile", d.Id())
 }
+
+func TestResourceUserInstanceProfileImport_NoRole(t *testing.T) {
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users/abc",
+			Response: ScimUser{
+				ID: "abc",
+			},
+		},
+	})
+	require.NoError(t, err)
+	defer server.Close()
+	r := ResourceUserInstanceProfile()
+	d := r.TestResourceData()
+	d.SetId("abc|arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile")
+	_, err = r.Importer.StateContext(context.Background(), d, client)
+	assert.EqualError(t, err, "cannot import abc|arn:aws:iam::999999999999:"+
+		"instance-profile/my-fake-instance-profile: User has no role")
+}
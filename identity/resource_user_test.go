@@ -366,3 +366,31 @@ func TestResourceUserDelete_Error(t *testing.T) {
 	}.Apply(t)
 	require.Error(t, err, err)
 }
+
+func TestResourceUserRead_UserNameCaseInsensitive(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				Response: ScimUser{
+					ID:          "abc",
+					DisplayName: "Example user",
+					Active:      true,
+					UserName:    "me@example.com",
+				},
+			},
+		},
+		Resource: ResourceUser(),
+		Read:     true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"user_name":    "Me@Example.com",
+			"display_name": "Example user",
+			"active":       "true",
+		},
+		HCL: `user_name = "Me@Example.com"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "me@example.com", d.Get("user_name"))
+}
@@ -94,6 +94,7 @@ type ScimGroup struct {
 	ID           string         `json:"id,omitempty"`
 	Schemas      []URN          `json:"schemas,omitempty"`
 	DisplayName  string         `json:"displayName,omitempty"`
+	ExternalID   string         `json:"externalId,omitempty"`
 	Members      []ComplexValue `json:"members,omitempty"`
 	Groups       []ComplexValue `json:"groups,omitempty"`
 	Roles        []ComplexValue `json:"roles,omitempty"`
@@ -0,0 +1,92 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+)
+
+// ManagedTokenInfo is metadata of any token in the workspace, as seen by workspace admin
+type ManagedTokenInfo struct {
+	TokenID           string `json:"token_id"`
+	Comment           string `json:"comment,omitempty"`
+	CreationTime      int64  `json:"creation_time,omitempty"`
+	ExpiryTime        int64  `json:"expiry_time,omitempty"`
+	CreatedByID       int64  `json:"created_by_id,omitempty"`
+	CreatedByUsername string `json:"created_by_username,omitempty"`
+	OwnerID           int64  `json:"owner_id,omitempty"`
+}
+
+// ManagedTokenList ...
+type ManagedTokenList struct {
+	TokenInfos []ManagedTokenInfo `json:"token_infos,omitempty"`
+}
+
+// TokenFilter selects workspace tokens. Empty filter matches all tokens.
+type TokenFilter struct {
+	CreatedByID       int64  `json:"created_by_id,omitempty"`
+	CreatedByUsername string `json:"created_by_username,omitempty"`
+	CommentContains   string `json:"comment_contains,omitempty"`
+	OlderThanDays     int    `json:"older_than_days,omitempty"`
+	WithoutExpiry     bool   `json:"without_expiry,omitempty"`
+}
+
+// matches checks criteria, that aren't supported by token management API
+func (f TokenFilter) matches(ti ManagedTokenInfo, now time.Time) bool {
+	if f.CommentContains != "" && !strings.Contains(ti.Comment, f.CommentContains) {
+		return false
+	}
+	if f.OlderThanDays > 0 {
+		cutoff := now.AddDate(0, 0, -f.OlderThanDays).UnixNano() / int64(time.Millisecond)
+		if ti.CreationTime > cutoff {
+			return false
+		}
+	}
+	if f.WithoutExpiry && ti.ExpiryTime > 0 {
+		return false
+	}
+	return true
+}
+
+// NewTokenManagementAPI creates TokenManagementAPI instance from provider meta
+func NewTokenManagementAPI(ctx context.Context, m interface{}) TokenManagementAPI {
+	return TokenManagementAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// TokenManagementAPI exposes tokens of all users in the workspace to admins
+type TokenManagementAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// List returns tokens, that match the filter
+func (a TokenManagementAPI) List(filter TokenFilter) ([]ManagedTokenInfo, error) {
+	query := map[string]string{}
+	if filter.CreatedByID != 0 {
+		query["created_by_id"] = fmt.Sprintf("%d", filter.CreatedByID)
+	}
+	if filter.CreatedByUsername != "" {
+		query["created_by_username"] = filter.CreatedByUsername
+	}
+	var tokenList ManagedTokenList
+	err := a.client.Get(a.context, "/token-management/tokens", query, &tokenList)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	tokens := []ManagedTokenInfo{}
+	for _, ti := range tokenList.TokenInfos {
+		if filter.matches(ti, now) {
+			tokens = append(tokens, ti)
+		}
+	}
+	return tokens, nil
+}
+
+// Delete revokes token of any user
+func (a TokenManagementAPI) Delete(tokenID string) error {
+	return a.client.Delete(a.context, "/token-management/tokens/"+tokenID, nil)
+}
@@ -12,8 +12,6 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/compute"
 	"github.com/databrickslabs/terraform-provider-databricks/internal"
 
-	"github.com/databrickslabs/terraform-provider-databricks/qa"
-
 	"github.com/databrickslabs/terraform-provider-databricks/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,8 +36,10 @@ type Step struct {
 
 // Test wrapper over terraform testing framework
 func Test(t *testing.T, steps []Step, otherVars ...map[string]string) {
+	recorder := testRecorder(t)
+	replaying := recorder != nil && recorder.Replaying()
 	cloudEnv := os.Getenv("CLOUD_ENV")
-	if cloudEnv == "" {
+	if cloudEnv == "" && !replaying {
 		t.Skip("Acceptance tests skipped unless env 'CLOUD_ENV' is set")
 	}
 	provider := provider.DatabricksProvider()
@@ -47,22 +47,28 @@ func Test(t *testing.T, steps []Step, otherVars ...map[string]string) {
 	if err != nil {
 		t.Skip(err.Error())
 	}
-	awsAttrs := ""
-	if cloudEnv == "AWS" {
-		awsAttrs = "aws_attributes {}"
-	}
-	instancePoolID := ""
-	if cloudEnv != "MWS" {
-		instancePoolID = compute.CommonInstancePoolID()
-	}
-	vars := map[string]string{
-		"CWD":                     cwd,
-		"AWS_ATTRIBUTES":          awsAttrs,
-		"COMMON_INSTANCE_POOL_ID": instancePoolID,
+	vars := map[string]string{}
+	if !replaying {
+		awsAttrs := ""
+		if cloudEnv == "AWS" {
+			awsAttrs = "aws_attributes {}"
+		}
+		instancePoolID := ""
+		if cloudEnv != "MWS" {
+			instancePoolID = compute.CommonInstancePoolID()
+		}
+		vars = map[string]string{
+			"CWD":                     cwd,
+			"AWS_ATTRIBUTES":          awsAttrs,
+			"COMMON_INSTANCE_POOL_ID": instancePoolID,
+		}
 	}
 	ts := []resource.TestStep{}
 	ctx := context.Background()
 	client := common.CommonEnvironmentClient()
+	if recorder != nil {
+		client = recordedClient(t, provider, recorder)
+	}
 
 	type testResource struct {
 		ID       string
@@ -73,9 +79,11 @@ func Test(t *testing.T, steps []Step, otherVars ...map[string]string) {
 	resourceAndName := regexp.MustCompile(`resource\s+"([^"]*)"\s+"([^"]*)"`)
 	resourcesEverCreated := map[testResource]bool{}
 	stepConfig := ""
+	templates := 0
 	for _, s := range steps {
 		if s.Template != "" {
-			stepConfig = qa.EnvironmentTemplate(t, s.Template, vars)
+			stepConfig = renderStep(t, recorder, templates, s.Template, vars)
+			templates++
 		}
 		ts = append(ts, resource.TestStep{
 			Config:                    stepConfig,
@@ -135,14 +143,16 @@ func Test(t *testing.T, steps []Step, otherVars ...map[string]string) {
 
 // AccTest wrapper for acceptance tests
 func AccTest(t *testing.T, tc resource.TestCase) {
-	tc.ProviderFactories = map[string]func() (*schema.Provider, error){
-		"databricks": func() (*schema.Provider, error) {
-			return provider.DatabricksProvider(), nil
-		},
+	if tc.ProviderFactories == nil {
+		tc.ProviderFactories = map[string]func() (*schema.Provider, error){
+			"databricks": func() (*schema.Provider, error) {
+				return provider.DatabricksProvider(), nil
+			},
+		}
 	}
 	// this allows to debug from VSCode if it's launched with CLOUD_ENV var
 	cloudEnv := os.Getenv("CLOUD_ENV")
-	tc.IsUnitTest = cloudEnv != ""
+	tc.IsUnitTest = cloudEnv != "" || os.Getenv("VCR_MODE") == "replay"
 	// TODO: all tests must: create, edit API, edit local
 	// TODO: generic resource destroy check
 	// TODO: fix tmpdir issue
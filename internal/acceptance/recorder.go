@@ -0,0 +1,104 @@
+package acceptance
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cassettePath returns location of recorded interactions for the test,
+// relative to the package of the test
+func cassettePath(t *testing.T) string {
+	name := strings.ReplaceAll(t.Name(), "/", "_")
+	return filepath.Join("testdata", "cassettes", name+".json")
+}
+
+// testRecorder returns recorder, if VCR_MODE environment variable is set to `record` or
+// `replay`. Recording requires real workspace and saves sanitized cassette, once the test
+// passes. Replay needs neither workspace nor credentials.
+func testRecorder(t *testing.T) *common.Recorder {
+	path := cassettePath(t)
+	switch mode := os.Getenv("VCR_MODE"); mode {
+	case "":
+		return nil
+	case "record":
+		recorder := common.NewRecorder()
+		t.Cleanup(func() {
+			if t.Failed() || t.Skipped() {
+				return
+			}
+			if err := recorder.Save(path); err != nil {
+				t.Errorf("Cannot save cassette: %s", err)
+			}
+		})
+		return recorder
+	case "replay":
+		recorder, err := common.LoadRecorder(path)
+		if os.IsNotExist(err) {
+			t.Skipf("No cassette recorded at %s", path)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		return recorder
+	default:
+		t.Fatalf("Unknown VCR_MODE: %s", mode)
+		return nil
+	}
+}
+
+// recordedClient makes provider and checks of the test to go through recorder
+func recordedClient(t *testing.T, p *schema.Provider, recorder *common.Recorder) *common.DatabricksClient {
+	configure := p.ConfigureContextFunc
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if recorder.Replaying() {
+			client := &common.DatabricksClient{Provider: p}
+			if err := client.Configure(); err != nil {
+				return nil, common.DiagnosticsFromErr(err)
+			}
+			client.WithRecorder(recorder)
+			return client, nil
+		}
+		m, diags := configure(ctx, d)
+		if diags.HasError() {
+			return m, diags
+		}
+		m.(*common.DatabricksClient).WithRecorder(recorder)
+		return m, diags
+	}
+	client := &common.DatabricksClient{}
+	if recorder.Replaying() {
+		if err := client.Configure(); err != nil {
+			t.Fatalf("Cannot configure replay client: %s", err)
+		}
+	} else {
+		client = common.NewClientFromEnvironment()
+	}
+	client.WithRecorder(recorder)
+	return client
+}
+
+// renderStep renders template of the step or takes it from cassette during replay,
+// as random names and environment variables have to be the same as during recording
+func renderStep(t *testing.T, recorder *common.Recorder, i int,
+	template string, vars map[string]string) string {
+	if recorder == nil {
+		return qa.EnvironmentTemplate(t, template, vars)
+	}
+	if recorder.Replaying() {
+		if i >= len(recorder.Cassette.Configs) {
+			t.Fatalf("Cassette %s is outdated, please record it again", cassettePath(t))
+		}
+		return recorder.Cassette.Configs[i]
+	}
+	config := qa.EnvironmentTemplate(t, template, vars)
+	recorder.Cassette.Configs = append(recorder.Cassette.Configs, config)
+	return config
+}
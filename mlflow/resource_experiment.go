@@ -0,0 +1,140 @@
+package mlflow
+
+import (
+	"context"
+	"log"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewExperimentsAPI creates ExperimentsAPI instance from provider meta
+func NewExperimentsAPI(ctx context.Context, m interface{}) ExperimentsAPI {
+	return ExperimentsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// ExperimentsAPI exposes the MLflow Experiments API
+type ExperimentsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Experiment is MLflow experiment, that is stored as an object in workspace
+type Experiment struct {
+	ExperimentID     string `json:"experiment_id,omitempty"`
+	Name             string `json:"name"`
+	ArtifactLocation string `json:"artifact_location,omitempty" tf:"computed"`
+	LifecycleStage   string `json:"lifecycle_stage,omitempty" tf:"computed"`
+	CreationTime     int64  `json:"creation_time,omitempty" tf:"computed"`
+	LastUpdateTime   int64  `json:"last_update_time,omitempty" tf:"computed"`
+}
+
+type experimentWrapper struct {
+	Experiment Experiment `json:"experiment"`
+}
+
+// Create creates MLflow experiment and returns its identifier
+func (a ExperimentsAPI) Create(e Experiment) (string, error) {
+	var created Experiment
+	err := a.client.Post(a.context, "/mlflow/experiments/create", Experiment{
+		Name:             e.Name,
+		ArtifactLocation: e.ArtifactLocation,
+	}, &created)
+	return created.ExperimentID, err
+}
+
+// Get returns MLflow experiment by identifier
+func (a ExperimentsAPI) Get(id string) (Experiment, error) {
+	var ew experimentWrapper
+	err := a.client.Get(a.context, "/mlflow/experiments/get", map[string]string{
+		"experiment_id": id,
+	}, &ew)
+	return ew.Experiment, err
+}
+
+// GetByName returns MLflow experiment by its name, including the deleted one
+func (a ExperimentsAPI) GetByName(name string) (Experiment, error) {
+	var ew experimentWrapper
+	err := a.client.Get(a.context, "/mlflow/experiments/get-by-name", map[string]string{
+		"experiment_name": name,
+	}, &ew)
+	return ew.Experiment, err
+}
+
+// Rename changes name of MLflow experiment
+func (a ExperimentsAPI) Rename(id, name string) error {
+	return a.client.Post(a.context, "/mlflow/experiments/update", map[string]string{
+		"experiment_id": id,
+		"new_name":      name,
+	}, nil)
+}
+
+// Restore brings back deleted MLflow experiment
+func (a ExperimentsAPI) Restore(id string) error {
+	return a.client.Post(a.context, "/mlflow/experiments/restore", map[string]string{
+		"experiment_id": id,
+	}, nil)
+}
+
+// Delete marks MLflow experiment as deleted
+func (a ExperimentsAPI) Delete(id string) error {
+	return a.client.Post(a.context, "/mlflow/experiments/delete", map[string]string{
+		"experiment_id": id,
+	}, nil)
+}
+
+// ResourceExperiment manages MLflow experiments
+func ResourceExperiment() *schema.Resource {
+	s := common.StructToSchema(Experiment{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		delete(s, "experiment_id")
+		s["artifact_location"].ForceNew = true
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var e Experiment
+			if err := common.DataToStructPointer(d, s, &e); err != nil {
+				return err
+			}
+			experimentsAPI := NewExperimentsAPI(ctx, c)
+			id, err := experimentsAPI.Create(e)
+			if apiErr, ok := err.(common.APIError); ok && apiErr.ErrorCode == "RESOURCE_ALREADY_EXISTS" {
+				// deleted experiments keep their names until they are permanently removed
+				existing, gerr := experimentsAPI.GetByName(e.Name)
+				if gerr != nil || existing.LifecycleStage != "deleted" {
+					return err
+				}
+				log.Printf("[INFO] Restoring deleted experiment %s", e.Name)
+				if err = experimentsAPI.Restore(existing.ExperimentID); err != nil {
+					return err
+				}
+				id = existing.ExperimentID
+			}
+			if err != nil {
+				return err
+			}
+			d.SetId(id)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			e, err := NewExperimentsAPI(ctx, c).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			if e.LifecycleStage == "deleted" {
+				log.Printf("[INFO] Experiment %s was deleted outside of Terraform", d.Id())
+				d.SetId("")
+				return nil
+			}
+			return common.StructToData(e, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewExperimentsAPI(ctx, c).Rename(d.Id(), d.Get("name").(string))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewExperimentsAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
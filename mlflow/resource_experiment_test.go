@@ -0,0 +1,219 @@
+package mlflow
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceExperimentCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceExperiment())
+}
+
+func TestResourceExperimentCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/experiments/create",
+				ExpectedRequest: Experiment{
+					Name:             "/Shared/experiment",
+					ArtifactLocation: "dbfs:/tmp/experiment",
+				},
+				Response: Experiment{
+					ExperimentID: "123",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/experiments/get?experiment_id=123",
+				Response: experimentWrapper{
+					Experiment: Experiment{
+						ExperimentID:     "123",
+						Name:             "/Shared/experiment",
+						ArtifactLocation: "dbfs:/tmp/experiment",
+						LifecycleStage:   "active",
+						CreationTime:     1000,
+						LastUpdateTime:   1000,
+					},
+				},
+			},
+		},
+		Resource: ResourceExperiment(),
+		Create:   true,
+		HCL: `
+		name = "/Shared/experiment"
+		artifact_location = "dbfs:/tmp/experiment"
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "123", d.Id())
+	assert.Equal(t, "active", d.Get("lifecycle_stage"))
+	assert.Equal(t, 1000, d.Get("creation_time"))
+}
+
+func TestResourceExperimentCreate_RestoresDeleted(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/experiments/create",
+				Status:   400,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_ALREADY_EXISTS",
+					Message:   "Experiment '/Shared/experiment' already exists in deleted state.",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/experiments/get-by-name?experiment_name=%2FShared%2Fexperiment",
+				Response: experimentWrapper{
+					Experiment: Experiment{
+						ExperimentID:   "123",
+						Name:           "/Shared/experiment",
+						LifecycleStage: "deleted",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/experiments/restore",
+				ExpectedRequest: map[string]string{
+					"experiment_id": "123",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/experiments/get?experiment_id=123",
+				Response: experimentWrapper{
+					Experiment: Experiment{
+						ExperimentID:     "123",
+						Name:             "/Shared/experiment",
+						ArtifactLocation: "dbfs:/databricks/mlflow-tracking/123",
+						LifecycleStage:   "active",
+					},
+				},
+			},
+		},
+		Resource: ResourceExperiment(),
+		Create:   true,
+		HCL:      `name = "/Shared/experiment"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "123", d.Id())
+	assert.Equal(t, "dbfs:/databricks/mlflow-tracking/123", d.Get("artifact_location"))
+}
+
+func TestResourceExperimentCreate_AlreadyExists(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/experiments/create",
+				Status:   400,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_ALREADY_EXISTS",
+					Message:   "Experiment '/Shared/experiment' already exists.",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/experiments/get-by-name?experiment_name=%2FShared%2Fexperiment",
+				Response: experimentWrapper{
+					Experiment: Experiment{
+						ExperimentID:   "123",
+						Name:           "/Shared/experiment",
+						LifecycleStage: "active",
+					},
+				},
+			},
+		},
+		Resource: ResourceExperiment(),
+		Create:   true,
+		HCL:      `name = "/Shared/experiment"`,
+	}.ExpectError(t, "Experiment '/Shared/experiment' already exists.")
+}
+
+func TestResourceExperimentRead_Deleted(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/experiments/get?experiment_id=123",
+				Response: experimentWrapper{
+					Experiment: Experiment{
+						ExperimentID:   "123",
+						Name:           "/Shared/experiment",
+						LifecycleStage: "deleted",
+					},
+				},
+			},
+		},
+		Resource: ResourceExperiment(),
+		Read:     true,
+		Removed:  true,
+		ID:       "123",
+	}.ApplyNoError(t)
+}
+
+func TestResourceExperimentUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/experiments/update",
+				ExpectedRequest: map[string]string{
+					"experiment_id": "123",
+					"new_name":      "/Shared/renamed",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/experiments/get?experiment_id=123",
+				Response: experimentWrapper{
+					Experiment: Experiment{
+						ExperimentID:     "123",
+						Name:             "/Shared/renamed",
+						ArtifactLocation: "dbfs:/tmp/experiment",
+						LifecycleStage:   "active",
+					},
+				},
+			},
+		},
+		Resource: ResourceExperiment(),
+		Update:   true,
+		ID:       "123",
+		InstanceState: map[string]string{
+			"name":              "/Shared/experiment",
+			"artifact_location": "dbfs:/tmp/experiment",
+		},
+		HCL: `
+		name = "/Shared/renamed"
+		artifact_location = "dbfs:/tmp/experiment"
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "/Shared/renamed", d.Get("name"))
+}
+
+func TestResourceExperimentDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/experiments/delete",
+				ExpectedRequest: map[string]string{
+					"experiment_id": "123",
+				},
+			},
+		},
+		Resource: ResourceExperiment(),
+		Delete:   true,
+		ID:       "123",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "123", d.Id())
+}
@@ -0,0 +1,173 @@
+package mlflow
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewRegisteredModelsAPI creates RegisteredModelsAPI instance from provider meta
+func NewRegisteredModelsAPI(ctx context.Context, m interface{}) RegisteredModelsAPI {
+	return RegisteredModelsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// RegisteredModelsAPI exposes the MLflow Model Registry API
+type RegisteredModelsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Tag is a key-value pair, that is attached to MLflow entity
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RegisteredModel is MLflow model in workspace model registry
+type RegisteredModel struct {
+	Name                 string `json:"name"`
+	Description          string `json:"description,omitempty"`
+	Tags                 []Tag  `json:"tags,omitempty" tf:"slice_set"`
+	RegisteredModelID    string `json:"id,omitempty" tf:"alias:registered_model_id,computed"`
+	CreationTimestamp    int64  `json:"creation_timestamp,omitempty" tf:"computed"`
+	LastUpdatedTimestamp int64  `json:"last_updated_timestamp,omitempty" tf:"computed"`
+	UserID               string `json:"user_id,omitempty" tf:"computed"`
+}
+
+type registeredModelWrapper struct {
+	RegisteredModel RegisteredModel `json:"registered_model_databricks"`
+}
+
+// Create registers MLflow model
+func (a RegisteredModelsAPI) Create(rm RegisteredModel) error {
+	return a.client.Post(a.context, "/mlflow/registered-models/create", RegisteredModel{
+		Name:        rm.Name,
+		Description: rm.Description,
+		Tags:        rm.Tags,
+	}, nil)
+}
+
+// Get returns registered MLflow model by name, including its workspace identifier
+func (a RegisteredModelsAPI) Get(name string) (RegisteredModel, error) {
+	var rmw registeredModelWrapper
+	err := a.client.Get(a.context, "/mlflow/databricks/registered-models/get", map[string]string{
+		"name": name,
+	}, &rmw)
+	return rmw.RegisteredModel, err
+}
+
+// Rename changes name of registered MLflow model
+func (a RegisteredModelsAPI) Rename(name, newName string) error {
+	return a.client.Post(a.context, "/mlflow/registered-models/rename", map[string]string{
+		"name":     name,
+		"new_name": newName,
+	}, nil)
+}
+
+// UpdateDescription changes description of registered MLflow model
+func (a RegisteredModelsAPI) UpdateDescription(name, description string) error {
+	return a.client.Patch(a.context, "/mlflow/registered-models/update", map[string]string{
+		"name":        name,
+		"description": description,
+	})
+}
+
+// SetTag sets tag on registered MLflow model
+func (a RegisteredModelsAPI) SetTag(name string, tag Tag) error {
+	return a.client.Post(a.context, "/mlflow/registered-models/set-tag", map[string]string{
+		"name":  name,
+		"key":   tag.Key,
+		"value": tag.Value,
+	}, nil)
+}
+
+// DeleteTag removes tag from registered MLflow model
+func (a RegisteredModelsAPI) DeleteTag(name, key string) error {
+	return a.client.Delete(a.context, "/mlflow/registered-models/delete-tag", map[string]string{
+		"name": name,
+		"key":  key,
+	})
+}
+
+// Delete removes registered MLflow model with all of its versions
+func (a RegisteredModelsAPI) Delete(name string) error {
+	return a.client.Delete(a.context, "/mlflow/registered-models/delete", map[string]string{
+		"name": name,
+	})
+}
+
+// ResourceModel manages models in workspace model registry
+func ResourceModel() *schema.Resource {
+	s := common.StructToSchema(RegisteredModel{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var rm RegisteredModel
+			if err := common.DataToStructPointer(d, s, &rm); err != nil {
+				return err
+			}
+			if err := NewRegisteredModelsAPI(ctx, c).Create(rm); err != nil {
+				return err
+			}
+			d.SetId(rm.Name)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			rm, err := NewRegisteredModelsAPI(ctx, c).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(rm, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var rm RegisteredModel
+			if err := common.DataToStructPointer(d, s, &rm); err != nil {
+				return err
+			}
+			modelsAPI := NewRegisteredModelsAPI(ctx, c)
+			if d.HasChange("name") {
+				if err := modelsAPI.Rename(d.Id(), rm.Name); err != nil {
+					return err
+				}
+				d.SetId(rm.Name)
+			}
+			if d.HasChange("description") {
+				if err := modelsAPI.UpdateDescription(rm.Name, rm.Description); err != nil {
+					return err
+				}
+			}
+			if !d.HasChange("tags") {
+				return nil
+			}
+			known := map[string]string{}
+			old, _ := d.GetChange("tags")
+			for _, v := range old.(*schema.Set).List() {
+				tag := v.(map[string]interface{})
+				known[tag["key"].(string)] = tag["value"].(string)
+			}
+			for _, tag := range rm.Tags {
+				value, ok := known[tag.Key]
+				delete(known, tag.Key)
+				if ok && value == tag.Value {
+					continue
+				}
+				if err := modelsAPI.SetTag(rm.Name, tag); err != nil {
+					return err
+				}
+			}
+			for key := range known {
+				if err := modelsAPI.DeleteTag(rm.Name, key); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewRegisteredModelsAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,313 @@
+package mlflow
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DefaultProvisionTimeout is the time, that it usually takes for serving endpoint to become ready
+const DefaultProvisionTimeout = 45 * time.Minute
+
+// OpenAIConfig configures access to OpenAI or Azure OpenAI models
+type OpenAIConfig struct {
+	OpenAIAPIKey         string `json:"openai_api_key"`
+	OpenAIAPIBase        string `json:"openai_api_base,omitempty"`
+	OpenAIAPIType        string `json:"openai_api_type,omitempty"`
+	OpenAIAPIVersion     string `json:"openai_api_version,omitempty"`
+	OpenAIDeploymentName string `json:"openai_deployment_name,omitempty"`
+	OpenAIOrganization   string `json:"openai_organization,omitempty"`
+}
+
+// AnthropicConfig configures access to Anthropic models
+type AnthropicConfig struct {
+	AnthropicAPIKey string `json:"anthropic_api_key"`
+}
+
+// AmazonBedrockConfig configures access to models on Amazon Bedrock
+type AmazonBedrockConfig struct {
+	AwsRegion          string `json:"aws_region"`
+	AwsAccessKeyID     string `json:"aws_access_key_id"`
+	AwsSecretAccessKey string `json:"aws_secret_access_key"`
+	BedrockProvider    string `json:"bedrock_provider"`
+}
+
+// CohereConfig configures access to Cohere models
+type CohereConfig struct {
+	CohereAPIKey string `json:"cohere_api_key"`
+}
+
+// ExternalModel is a model, that is hosted outside of Databricks and is proxied by serving endpoint
+type ExternalModel struct {
+	Name                string               `json:"name"`
+	Provider            string               `json:"provider"`
+	Task                string               `json:"task"`
+	OpenAIConfig        *OpenAIConfig        `json:"openai_config,omitempty"`
+	AnthropicConfig     *AnthropicConfig     `json:"anthropic_config,omitempty"`
+	AmazonBedrockConfig *AmazonBedrockConfig `json:"amazon_bedrock_config,omitempty"`
+	CohereConfig        *CohereConfig        `json:"cohere_config,omitempty"`
+}
+
+// ServedEntity is either a registered model version or an external model, that is served by an endpoint
+type ServedEntity struct {
+	Name                     string            `json:"name,omitempty" tf:"computed"`
+	EntityName               string            `json:"entity_name,omitempty"`
+	EntityVersion            string            `json:"entity_version,omitempty"`
+	WorkloadSize             string            `json:"workload_size,omitempty"`
+	ScaleToZeroEnabled       bool              `json:"scale_to_zero_enabled,omitempty"`
+	EnvironmentVars          map[string]string `json:"environment_vars,omitempty"`
+	ExternalModel            *ExternalModel    `json:"external_model,omitempty"`
+	MinProvisionedThroughput int               `json:"min_provisioned_throughput,omitempty"`
+	MaxProvisionedThroughput int               `json:"max_provisioned_throughput,omitempty"`
+	ProvisionedModelUnits    int               `json:"provisioned_model_units,omitempty"`
+}
+
+func (se ServedEntity) validate() error {
+	provisioned := se.MinProvisionedThroughput > 0 || se.MaxProvisionedThroughput > 0 ||
+		se.ProvisionedModelUnits > 0
+	if provisioned && se.WorkloadSize != "" {
+		return fmt.Errorf("served entity %s: workload_size can't be used "+
+			"together with provisioned throughput", se.EntityName)
+	}
+	if se.MinProvisionedThroughput > se.MaxProvisionedThroughput && se.MaxProvisionedThroughput > 0 {
+		return fmt.Errorf("served entity %s: min_provisioned_throughput %d is more than "+
+			"max_provisioned_throughput %d", se.EntityName,
+			se.MinProvisionedThroughput, se.MaxProvisionedThroughput)
+	}
+	return nil
+}
+
+// TrafficRoute sends a percentage of requests to one of served entities
+type TrafficRoute struct {
+	ServedModelName   string `json:"served_model_name"`
+	TrafficPercentage int    `json:"traffic_percentage"`
+}
+
+// TrafficConfig splits traffic between served entities
+type TrafficConfig struct {
+	Routes []TrafficRoute `json:"routes"`
+}
+
+// AutoCaptureConfig logs requests and responses of endpoint to inference table in Unity Catalog
+type AutoCaptureConfig struct {
+	CatalogName     string `json:"catalog_name,omitempty"`
+	SchemaName      string `json:"schema_name,omitempty"`
+	TableNamePrefix string `json:"table_name_prefix,omitempty"`
+	Enabled         bool   `json:"enabled,omitempty"`
+}
+
+// EndpointCoreConfig holds served entities and traffic split between them
+type EndpointCoreConfig struct {
+	ServedEntities    []ServedEntity     `json:"served_entities"`
+	TrafficConfig     *TrafficConfig     `json:"traffic_config,omitempty" tf:"computed"`
+	AutoCaptureConfig *AutoCaptureConfig `json:"auto_capture_config,omitempty"`
+}
+
+// UsageTrackingConfig enables tracking of token usage in system tables
+type UsageTrackingConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+// AIGateway configures governance features of serving endpoint
+type AIGateway struct {
+	UsageTrackingConfig *UsageTrackingConfig `json:"usage_tracking_config,omitempty"`
+}
+
+func (c *EndpointCoreConfig) validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, se := range c.ServedEntities {
+		if err := se.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EndpointState describes readiness of serving endpoint and progress of its config update
+type EndpointState struct {
+	Ready        string `json:"ready,omitempty"`
+	ConfigUpdate string `json:"config_update,omitempty"`
+}
+
+// ServingEndpoint is a REST API endpoint, that serves MLflow models
+type ServingEndpoint struct {
+	Name              string              `json:"name"`
+	Config            *EndpointCoreConfig `json:"config"`
+	AIGateway         *AIGateway          `json:"ai_gateway,omitempty"`
+	ServingEndpointID string              `json:"id,omitempty" tf:"alias:serving_endpoint_id,computed"`
+	State             *EndpointState      `json:"state,omitempty"`
+}
+
+// NewServingEndpointsAPI creates ServingEndpointsAPI instance from provider meta
+func NewServingEndpointsAPI(ctx context.Context, m interface{}) ServingEndpointsAPI {
+	return ServingEndpointsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// ServingEndpointsAPI exposes the Model Serving API
+type ServingEndpointsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Create creates serving endpoint and waits until it's ready
+func (a ServingEndpointsAPI) Create(se ServingEndpoint, timeout time.Duration) error {
+	err := a.client.Post(a.context, "/serving-endpoints", ServingEndpoint{
+		Name:      se.Name,
+		Config:    se.Config,
+		AIGateway: se.AIGateway,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	return a.waitForReady(se.Name, timeout)
+}
+
+// Get returns serving endpoint by name
+func (a ServingEndpointsAPI) Get(name string) (se ServingEndpoint, err error) {
+	err = a.client.Get(a.context, "/serving-endpoints/"+name, nil, &se)
+	return
+}
+
+// UpdateConfig replaces served entities and traffic config of endpoint and waits until it's ready
+func (a ServingEndpointsAPI) UpdateConfig(name string, config EndpointCoreConfig,
+	timeout time.Duration) error {
+	err := a.client.Put(a.context, "/serving-endpoints/"+name+"/config", config)
+	if err != nil {
+		return err
+	}
+	return a.waitForReady(name, timeout)
+}
+
+// UpdateAIGateway changes governance features of serving endpoint
+func (a ServingEndpointsAPI) UpdateAIGateway(name string, gateway AIGateway) error {
+	return a.client.Put(a.context, "/serving-endpoints/"+name+"/ai-gateway", gateway)
+}
+
+// Delete removes serving endpoint
+func (a ServingEndpointsAPI) Delete(name string) error {
+	return a.client.Delete(a.context, "/serving-endpoints/"+name, nil)
+}
+
+func (a ServingEndpointsAPI) waitForReady(name string, timeout time.Duration) error {
+	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		se, err := a.Get(name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if se.State == nil {
+			return resource.RetryableError(fmt.Errorf("serving endpoint %s has no state yet", name))
+		}
+		if se.State.ConfigUpdate == "UPDATE_FAILED" {
+			return resource.NonRetryableError(fmt.Errorf(
+				"serving endpoint %s failed to update its config", name))
+		}
+		if se.State.ConfigUpdate == "NOT_UPDATING" && se.State.Ready == "READY" {
+			return nil
+		}
+		message := fmt.Sprintf("serving endpoint %s is %s with config update %s",
+			name, se.State.Ready, se.State.ConfigUpdate)
+		log.Printf("[DEBUG] %s", message)
+		return resource.RetryableError(fmt.Errorf(message))
+	})
+}
+
+// ResourceModelServing manages serving endpoints for MLflow models
+func ResourceModelServing() *schema.Resource {
+	s := common.StructToSchema(ServingEndpoint{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		delete(m, "state")
+		m["name"].ForceNew = true
+		if v, err := common.SchemaPath(m, "config", "served_entities", "workload_size"); err == nil {
+			v.ValidateFunc = validation.StringInSlice([]string{"Small", "Medium", "Large"}, false)
+		}
+		if v, err := common.SchemaPath(m, "config", "served_entities", "external_model", "provider"); err == nil {
+			v.ValidateFunc = validation.StringInSlice([]string{"openai", "anthropic",
+				"amazon-bedrock", "cohere", "ai21labs", "google-cloud-vertex-ai",
+				"databricks-model-serving"}, false)
+		}
+		secrets := [][]string{
+			{"openai_config", "openai_api_key"},
+			{"anthropic_config", "anthropic_api_key"},
+			{"amazon_bedrock_config", "aws_access_key_id"},
+			{"amazon_bedrock_config", "aws_secret_access_key"},
+			{"cohere_config", "cohere_api_key"},
+		}
+		for _, secret := range secrets {
+			path := append([]string{"config", "served_entities", "external_model"}, secret...)
+			if v, err := common.SchemaPath(m, path...); err == nil {
+				v.Sensitive = true
+			}
+		}
+		// environment variables frequently carry secret references or plain credentials
+		if v, err := common.SchemaPath(m, "config", "served_entities", "environment_vars"); err == nil {
+			v.Sensitive = true
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var se ServingEndpoint
+			if err := common.DataToStructPointer(d, s, &se); err != nil {
+				return err
+			}
+			if err := se.Config.validate(); err != nil {
+				return err
+			}
+			if err := NewServingEndpointsAPI(ctx, c).Create(se, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+			d.SetId(se.Name)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			se, err := NewServingEndpointsAPI(ctx, c).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(se, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var se ServingEndpoint
+			if err := common.DataToStructPointer(d, s, &se); err != nil {
+				return err
+			}
+			servingAPI := NewServingEndpointsAPI(ctx, c)
+			if d.HasChange("ai_gateway") {
+				var gateway AIGateway
+				if se.AIGateway != nil {
+					gateway = *se.AIGateway
+				}
+				if err := servingAPI.UpdateAIGateway(d.Id(), gateway); err != nil {
+					return err
+				}
+			}
+			if !d.HasChange("config") {
+				return nil
+			}
+			if err := se.Config.validate(); err != nil {
+				return err
+			}
+			var config EndpointCoreConfig
+			if se.Config != nil {
+				config = *se.Config
+			}
+			return servingAPI.UpdateConfig(d.Id(), config, d.Timeout(schema.TimeoutUpdate))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewServingEndpointsAPI(ctx, c).Delete(d.Id())
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
+			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
+		},
+	}.ToResource()
+}
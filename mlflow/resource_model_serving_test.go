@@ -0,0 +1,617 @@
+package mlflow
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceModelServingCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceModelServing())
+}
+
+func TestResourceModelServingCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/serving-endpoints",
+				ExpectedRequest: ServingEndpoint{
+					Name: "churn",
+					Config: &EndpointCoreConfig{
+						ServedEntities: []ServedEntity{
+							{
+								EntityName:         "churn",
+								EntityVersion:      "1",
+								WorkloadSize:       "Small",
+								ScaleToZeroEnabled: true,
+								EnvironmentVars: map[string]string{
+									"API_TOKEN": "{{secrets/scope/token}}",
+								},
+							},
+							{
+								Name:          "challenger",
+								EntityName:    "churn",
+								EntityVersion: "2",
+								WorkloadSize:  "Small",
+							},
+						},
+						TrafficConfig: &TrafficConfig{
+							Routes: []TrafficRoute{
+								{
+									ServedModelName:   "churn-1",
+									TrafficPercentage: 90,
+								},
+								{
+									ServedModelName:   "challenger",
+									TrafficPercentage: 10,
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/serving-endpoints/churn",
+				Response: ServingEndpoint{
+					Name: "churn",
+					State: &EndpointState{
+						Ready:        "NOT_READY",
+						ConfigUpdate: "IN_PROGRESS",
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/serving-endpoints/churn",
+				ReuseRequest: true,
+				Response: ServingEndpoint{
+					Name:              "churn",
+					ServingEndpointID: "abc",
+					State: &EndpointState{
+						Ready:        "READY",
+						ConfigUpdate: "NOT_UPDATING",
+					},
+					Config: &EndpointCoreConfig{
+						ServedEntities: []ServedEntity{
+							{
+								Name:               "churn-1",
+								EntityName:         "churn",
+								EntityVersion:      "1",
+								WorkloadSize:       "Small",
+								ScaleToZeroEnabled: true,
+								EnvironmentVars: map[string]string{
+									"API_TOKEN": "{{secrets/scope/token}}",
+								},
+							},
+							{
+								Name:          "challenger",
+								EntityName:    "churn",
+								EntityVersion: "2",
+								WorkloadSize:  "Small",
+							},
+						},
+						TrafficConfig: &TrafficConfig{
+							Routes: []TrafficRoute{
+								{
+									ServedModelName:   "churn-1",
+									TrafficPercentage: 90,
+								},
+								{
+									ServedModelName:   "challenger",
+									TrafficPercentage: 10,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceModelServing(),
+		Create:   true,
+		HCL: `
+		name = "churn"
+		config {
+			served_entities {
+				entity_name = "churn"
+				entity_version = "1"
+				workload_size = "Small"
+				scale_to_zero_enabled = true
+				environment_vars = {
+					"API_TOKEN" = "{{secrets/scope/token}}"
+				}
+			}
+			served_entities {
+				name = "challenger"
+				entity_name = "churn"
+				entity_version = "2"
+				workload_size = "Small"
+			}
+			traffic_config {
+				routes {
+					served_model_name = "churn-1"
+					traffic_percentage = 90
+				}
+				routes {
+					served_model_name = "challenger"
+					traffic_percentage = 10
+				}
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "churn", d.Id())
+	assert.Equal(t, "abc", d.Get("serving_endpoint_id"))
+	assert.Equal(t, "churn-1", d.Get("config.0.served_entities.0.name"))
+}
+
+func TestResourceModelServingCreate_UpdateFailed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/serving-endpoints",
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/serving-endpoints/churn",
+				Response: ServingEndpoint{
+					Name: "churn",
+					State: &EndpointState{
+						Ready:        "NOT_READY",
+						ConfigUpdate: "UPDATE_FAILED",
+					},
+				},
+			},
+		},
+		Resource: ResourceModelServing(),
+		Create:   true,
+		HCL: `
+		name = "churn"
+		config {
+			served_entities {
+				entity_name = "churn"
+				entity_version = "1"
+				workload_size = "Small"
+			}
+		}
+		`,
+	}.ExpectError(t, "serving endpoint churn failed to update its config")
+}
+
+func TestResourceModelServingCreate_InvalidWorkloadSize(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceModelServing(),
+		Create:   true,
+		HCL: `
+		name = "churn"
+		config {
+			served_entities {
+				entity_name = "churn"
+				entity_version = "1"
+				workload_size = "Huge"
+			}
+		}
+		`,
+	}.ExpectError(t, "invalid config supplied. [config.#.served_entities.#.workload_size] "+
+		"expected config.0.served_entities.0.workload_size to be one of [Small Medium Large], got Huge")
+}
+
+func TestResourceModelServingUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/serving-endpoints/churn/config",
+				ExpectedRequest: EndpointCoreConfig{
+					ServedEntities: []ServedEntity{
+						{
+							Name:          "churn-2",
+							EntityName:    "churn",
+							EntityVersion: "2",
+							WorkloadSize:  "Medium",
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/serving-endpoints/churn",
+				ReuseRequest: true,
+				Response: ServingEndpoint{
+					Name: "churn",
+					State: &EndpointState{
+						Ready:        "READY",
+						ConfigUpdate: "NOT_UPDATING",
+					},
+					Config: &EndpointCoreConfig{
+						ServedEntities: []ServedEntity{
+							{
+								Name:          "churn-2",
+								EntityName:    "churn",
+								EntityVersion: "2",
+								WorkloadSize:  "Medium",
+							},
+						},
+						TrafficConfig: &TrafficConfig{
+							Routes: []TrafficRoute{
+								{
+									ServedModelName:   "churn-2",
+									TrafficPercentage: 100,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceModelServing(),
+		Update:   true,
+		ID:       "churn",
+		InstanceState: map[string]string{
+			"name": "churn",
+		},
+		HCL: `
+		name = "churn"
+		config {
+			served_entities {
+				name = "churn-2"
+				entity_name = "churn"
+				entity_version = "2"
+				workload_size = "Medium"
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, 100, d.Get("config.0.traffic_config.0.routes.0.traffic_percentage"))
+}
+
+func TestResourceModelServingDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/serving-endpoints/churn",
+			},
+		},
+		Resource: ResourceModelServing(),
+		Delete:   true,
+		ID:       "churn",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "churn", d.Id())
+}
+
+func TestResourceModelServingCreate_ExternalModel(t *testing.T) {
+	externalModel := ServingEndpoint{
+		Name: "gateway",
+		Config: &EndpointCoreConfig{
+			ServedEntities: []ServedEntity{
+				{
+					Name: "claude",
+					ExternalModel: &ExternalModel{
+						Name:     "claude-3-5-sonnet",
+						Provider: "anthropic",
+						Task:     "llm/v1/chat",
+						AnthropicConfig: &AnthropicConfig{
+							AnthropicAPIKey: "{{secrets/llm/anthropic}}",
+						},
+					},
+				},
+			},
+		},
+		AIGateway: &AIGateway{
+			UsageTrackingConfig: &UsageTrackingConfig{
+				Enabled: true,
+			},
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/serving-endpoints",
+				ExpectedRequest: externalModel,
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/serving-endpoints/gateway",
+				ReuseRequest: true,
+				Response: ServingEndpoint{
+					Name:              "gateway",
+					ServingEndpointID: "abc",
+					Config:            externalModel.Config,
+					AIGateway:         externalModel.AIGateway,
+					State: &EndpointState{
+						Ready:        "READY",
+						ConfigUpdate: "NOT_UPDATING",
+					},
+				},
+			},
+		},
+		Resource: ResourceModelServing(),
+		Create:   true,
+		HCL: `
+		name = "gateway"
+		config {
+			served_entities {
+				name = "claude"
+				external_model {
+					name = "claude-3-5-sonnet"
+					provider = "anthropic"
+					task = "llm/v1/chat"
+					anthropic_config {
+						anthropic_api_key = "{{secrets/llm/anthropic}}"
+					}
+				}
+			}
+		}
+		ai_gateway {
+			usage_tracking_config {
+				enabled = true
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "gateway", d.Id())
+	assert.Equal(t, "anthropic", d.Get("config.0.served_entities.0.external_model.0.provider"))
+	assert.Equal(t, true, d.Get("ai_gateway.0.usage_tracking_config.0.enabled"))
+}
+
+func TestResourceModelServingCreate_InvalidProvider(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceModelServing(),
+		Create:   true,
+		HCL: `
+		name = "gateway"
+		config {
+			served_entities {
+				external_model {
+					name = "gpt-4o"
+					provider = "skynet"
+					task = "llm/v1/chat"
+				}
+			}
+		}
+		`,
+	}.Apply(t)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected config.0.served_entities.0.external_model.0.provider "+
+		"to be one of [openai anthropic amazon-bedrock cohere ai21labs google-cloud-vertex-ai "+
+		"databricks-model-serving], got skynet")
+}
+
+func TestResourceModelServingUpdate_AIGateway(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/serving-endpoints/gateway/ai-gateway",
+				ExpectedRequest: AIGateway{
+					UsageTrackingConfig: &UsageTrackingConfig{
+						Enabled: true,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/serving-endpoints/gateway",
+				Response: ServingEndpoint{
+					Name: "gateway",
+					Config: &EndpointCoreConfig{
+						ServedEntities: []ServedEntity{
+							{
+								Name:         "gpt",
+								EntityName:   "gpt",
+								WorkloadSize: "Small",
+							},
+						},
+					},
+					AIGateway: &AIGateway{
+						UsageTrackingConfig: &UsageTrackingConfig{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceModelServing(),
+		Update:   true,
+		ID:       "gateway",
+		InstanceState: map[string]string{
+			"name":                                     "gateway",
+			"config.#":                                 "1",
+			"config.0.served_entities.#":               "1",
+			"config.0.served_entities.0.name":          "gpt",
+			"config.0.served_entities.0.entity_name":   "gpt",
+			"config.0.served_entities.0.workload_size": "Small",
+		},
+		HCL: `
+		name = "gateway"
+		config {
+			served_entities {
+				name = "gpt"
+				entity_name = "gpt"
+				workload_size = "Small"
+			}
+		}
+		ai_gateway {
+			usage_tracking_config {
+				enabled = true
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, true, d.Get("ai_gateway.0.usage_tracking_config.0.enabled"))
+}
+
+func TestResourceModelServingCreate_AutoCapture(t *testing.T) {
+	config := &EndpointCoreConfig{
+		ServedEntities: []ServedEntity{
+			{
+				Name:          "churn-1",
+				EntityName:    "churn",
+				EntityVersion: "1",
+				WorkloadSize:  "Small",
+			},
+		},
+		AutoCaptureConfig: &AutoCaptureConfig{
+			CatalogName:     "ml",
+			SchemaName:      "inference",
+			TableNamePrefix: "churn",
+			Enabled:         true,
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/serving-endpoints",
+				ExpectedRequest: ServingEndpoint{
+					Name: "churn",
+					Config: &EndpointCoreConfig{
+						ServedEntities: []ServedEntity{
+							{
+								EntityName:    "churn",
+								EntityVersion: "1",
+								WorkloadSize:  "Small",
+							},
+						},
+						AutoCaptureConfig: config.AutoCaptureConfig,
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/serving-endpoints/churn",
+				ReuseRequest: true,
+				Response: ServingEndpoint{
+					Name:   "churn",
+					Config: config,
+					State: &EndpointState{
+						Ready:        "READY",
+						ConfigUpdate: "NOT_UPDATING",
+					},
+				},
+			},
+		},
+		Resource: ResourceModelServing(),
+		Create:   true,
+		HCL: `
+		name = "churn"
+		config {
+			served_entities {
+				entity_name = "churn"
+				entity_version = "1"
+				workload_size = "Small"
+			}
+			auto_capture_config {
+				catalog_name = "ml"
+				schema_name = "inference"
+				table_name_prefix = "churn"
+				enabled = true
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "inference", d.Get("config.0.auto_capture_config.0.schema_name"))
+	assert.Equal(t, true, d.Get("config.0.auto_capture_config.0.enabled"))
+}
+
+func TestResourceModelServingCreate_ProvisionedThroughput(t *testing.T) {
+	entity := ServedEntity{
+		EntityName:               "system.ai.llama_v3_70b_instruct",
+		EntityVersion:            "2",
+		MinProvisionedThroughput: 0,
+		MaxProvisionedThroughput: 9500,
+		ScaleToZeroEnabled:       true,
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/serving-endpoints",
+				ExpectedRequest: ServingEndpoint{
+					Name: "llama",
+					Config: &EndpointCoreConfig{
+						ServedEntities: []ServedEntity{entity},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/serving-endpoints/llama",
+				ReuseRequest: true,
+				Response: ServingEndpoint{
+					Name: "llama",
+					Config: &EndpointCoreConfig{
+						ServedEntities: []ServedEntity{entity},
+					},
+					State: &EndpointState{
+						Ready:        "READY",
+						ConfigUpdate: "NOT_UPDATING",
+					},
+				},
+			},
+		},
+		Resource: ResourceModelServing(),
+		Create:   true,
+		HCL: `
+		name = "llama"
+		config {
+			served_entities {
+				entity_name = "system.ai.llama_v3_70b_instruct"
+				entity_version = "2"
+				max_provisioned_throughput = 9500
+				scale_to_zero_enabled = true
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, 9500, d.Get("config.0.served_entities.0.max_provisioned_throughput"))
+}
+
+func TestResourceModelServingCreate_ProvisionedThroughputWithWorkloadSize(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceModelServing(),
+		Create:   true,
+		HCL: `
+		name = "llama"
+		config {
+			served_entities {
+				entity_name = "llama"
+				entity_version = "2"
+				workload_size = "Small"
+				provisioned_model_units = 50
+			}
+		}
+		`,
+	}.ExpectError(t, "served entity llama: workload_size can't be used together with provisioned throughput")
+}
+
+func TestResourceModelServingCreate_ProvisionedThroughputRange(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceModelServing(),
+		Create:   true,
+		HCL: `
+		name = "llama"
+		config {
+			served_entities {
+				entity_name = "llama"
+				entity_version = "2"
+				min_provisioned_throughput = 1900
+				max_provisioned_throughput = 950
+			}
+		}
+		`,
+	}.ExpectError(t, "served entity llama: min_provisioned_throughput 1900 is more than max_provisioned_throughput 950")
+}
@@ -0,0 +1,187 @@
+package mlflow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceModelCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceModel())
+}
+
+func TestResourceModelCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/registered-models/create",
+				ExpectedRequest: RegisteredModel{
+					Name:        "churn",
+					Description: "Customer churn",
+					Tags: []Tag{
+						{Key: "team", Value: "growth"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/databricks/registered-models/get?name=churn",
+				Response: registeredModelWrapper{
+					RegisteredModel: RegisteredModel{
+						Name:        "churn",
+						Description: "Customer churn",
+						Tags: []Tag{
+							{Key: "team", Value: "growth"},
+						},
+						RegisteredModelID: "abc",
+						CreationTimestamp: 1000,
+						UserID:            "me@example.com",
+					},
+				},
+			},
+		},
+		Resource: ResourceModel(),
+		Create:   true,
+		HCL: `
+		name = "churn"
+		description = "Customer churn"
+		tags {
+			key = "team"
+			value = "growth"
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "churn", d.Id())
+	assert.Equal(t, "abc", d.Get("registered_model_id"))
+	assert.Equal(t, "me@example.com", d.Get("user_id"))
+}
+
+func TestResourceModelRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/databricks/registered-models/get?name=churn",
+				Status:   404,
+				Response: map[string]string{
+					"error_code": "RESOURCE_DOES_NOT_EXIST",
+					"message":    "Registered Model with name=churn not found",
+				},
+			},
+		},
+		Resource: ResourceModel(),
+		Read:     true,
+		Removed:  true,
+		ID:       "churn",
+	}.ApplyNoError(t)
+}
+
+func TestResourceModelUpdate(t *testing.T) {
+	tagHash := func(key, value string) string {
+		tags := ResourceModel().Schema["tags"].Elem.(*schema.Resource)
+		return fmt.Sprint(schema.HashResource(tags)(map[string]interface{}{
+			"key":   key,
+			"value": value,
+		}))
+	}
+	team, stale := tagHash("team", "growth"), tagHash("stale", "yes")
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/registered-models/rename",
+				ExpectedRequest: map[string]string{
+					"name":     "churn",
+					"new_name": "churn-v2",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/mlflow/registered-models/update",
+				ExpectedRequest: map[string]string{
+					"name":        "churn-v2",
+					"description": "Updated",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/registered-models/set-tag",
+				ExpectedRequest: map[string]string{
+					"name":  "churn-v2",
+					"key":   "team",
+					"value": "retention",
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/mlflow/registered-models/delete-tag",
+				ExpectedRequest: map[string]string{
+					"name": "churn-v2",
+					"key":  "stale",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/databricks/registered-models/get?name=churn-v2",
+				Response: registeredModelWrapper{
+					RegisteredModel: RegisteredModel{
+						Name:        "churn-v2",
+						Description: "Updated",
+						Tags: []Tag{
+							{Key: "team", Value: "retention"},
+						},
+						RegisteredModelID: "abc",
+					},
+				},
+			},
+		},
+		Resource: ResourceModel(),
+		Update:   true,
+		ID:       "churn",
+		InstanceState: map[string]string{
+			"name":                     "churn",
+			"description":              "Customer churn",
+			"tags.#":                   "2",
+			"tags." + team + ".key":    "team",
+			"tags." + team + ".value":  "growth",
+			"tags." + stale + ".key":   "stale",
+			"tags." + stale + ".value": "yes",
+		},
+		HCL: `
+		name = "churn-v2"
+		description = "Updated"
+		tags {
+			key = "team"
+			value = "retention"
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "churn-v2", d.Id())
+	assert.Equal(t, "Updated", d.Get("description"))
+}
+
+func TestResourceModelDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/mlflow/registered-models/delete",
+				ExpectedRequest: map[string]string{
+					"name": "churn",
+				},
+			},
+		},
+		Resource: ResourceModel(),
+		Delete:   true,
+		ID:       "churn",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "churn", d.Id())
+}
@@ -41,7 +41,7 @@ func (a WorkspacesAPI) Create(ws *Workspace, timeout time.Duration) error {
 	}
 	if err = a.WaitForRunning(*ws, timeout); err != nil {
 		log.Printf("[ERROR] Deleting failed workspace: %s", err)
-		if derr := a.Delete(ws.AccountID, fmt.Sprintf("%d", ws.WorkspaceID)); derr != nil {
+		if derr := a.Delete(ws.AccountID, fmt.Sprintf("%d", ws.WorkspaceID), timeout); derr != nil {
 			return fmt.Errorf("%s - %s", err, derr)
 		}
 		return err
@@ -157,13 +157,13 @@ func (a WorkspacesAPI) Read(mwsAcctID, workspaceID string) (Workspace, error) {
 
 // Delete will delete the configuration for the workspace given a workspace id and will not block. A follow up email
 // will be sent when the workspace is fully deleted.
-func (a WorkspacesAPI) Delete(mwsAcctID, workspaceID string) error {
+func (a WorkspacesAPI) Delete(mwsAcctID, workspaceID string, timeout time.Duration) error {
 	workspacesAPIPath := fmt.Sprintf("/accounts/%s/workspaces/%s", mwsAcctID, workspaceID)
 	err := a.client.Delete(a.context, workspacesAPIPath, nil)
 	if err != nil {
 		return err
 	}
-	return resource.RetryContext(a.context, 15*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
 		workspace, err := a.Read(mwsAcctID, workspaceID)
 		if e, ok := err.(common.APIError); ok && e.IsMissing() {
 			log.Printf("[INFO] Workspace %s/%s is removed.", mwsAcctID, workspaceID)
@@ -282,12 +282,13 @@ func ResourceWorkspace() *schema.Resource {
 			if err != nil {
 				return err
 			}
-			return NewWorkspacesAPI(ctx, c).Delete(accountID, workspaceID)
+			return NewWorkspacesAPI(ctx, c).Delete(accountID, workspaceID, d.Timeout(schema.TimeoutDelete))
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
 			Read:   schema.DefaultTimeout(DefaultProvisionTimeout),
 			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
+			Delete: schema.DefaultTimeout(DefaultProvisionTimeout),
 		},
 	}.ToResource()
 }
@@ -8,12 +8,17 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/databrickslabs/terraform-provider-databricks/access"
+	"github.com/databrickslabs/terraform-provider-databricks/apps"
+	"github.com/databrickslabs/terraform-provider-databricks/catalog"
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/compute"
 	"github.com/databrickslabs/terraform-provider-databricks/identity"
+	"github.com/databrickslabs/terraform-provider-databricks/mlflow"
 	"github.com/databrickslabs/terraform-provider-databricks/mws"
+	"github.com/databrickslabs/terraform-provider-databricks/settings"
 	"github.com/databrickslabs/terraform-provider-databricks/sqlanalytics"
 	"github.com/databrickslabs/terraform-provider-databricks/storage"
 	"github.com/databrickslabs/terraform-provider-databricks/workspace"
@@ -23,19 +28,31 @@ import (
 func DatabricksProvider() *schema.Provider {
 	p := &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
-			"databricks_aws_crossaccount_policy": access.DataAwsCrossAccountPolicy(),
-			"databricks_aws_assume_role_policy":  access.DataAwsAssumeRolePolicy(),
-			"databricks_aws_bucket_policy":       access.DataAwsBucketPolicy(),
-			"databricks_current_user":            identity.DataSourceCurrentUser(),
-			"databricks_dbfs_file":               storage.DataSourceDBFSFile(),
-			"databricks_dbfs_file_paths":         storage.DataSourceDBFSFilePaths(),
-			"databricks_group":                   identity.DataSourceGroup(),
-			"databricks_node_type":               compute.DataSourceNodeType(),
-			"databricks_notebook":                workspace.DataSourceNotebook(),
-			"databricks_notebook_paths":          workspace.DataSourceNotebookPaths(),
-			"databricks_spark_version":           compute.DataSourceSparkVersion(),
-			"databricks_user":                    identity.DataSourceUser(),
-			"databricks_zones":                   compute.DataSourceClusterZones(),
+			"databricks_alert":                                sqlanalytics.DataSourceAlert(),
+			"databricks_aws_crossaccount_policy":              access.DataAwsCrossAccountPolicy(),
+			"databricks_aws_assume_role_policy":               access.DataAwsAssumeRolePolicy(),
+			"databricks_aws_bucket_policy":                    access.DataAwsBucketPolicy(),
+			"databricks_aws_unity_catalog_assume_role_policy": access.DataAwsUnityCatalogAssumeRolePolicy(),
+			"databricks_aws_unity_catalog_policy":             access.DataAwsUnityCatalogPolicy(),
+			"databricks_cluster_library_status":               compute.DataSourceClusterLibraryStatus(),
+			"databricks_current_metastore":                    catalog.DataSourceCurrentMetastore(),
+			"databricks_current_user":                         identity.DataSourceCurrentUser(),
+			"databricks_dbfs_file":                            storage.DataSourceDBFSFile(),
+			"databricks_dbfs_file_paths":                      storage.DataSourceDBFSFilePaths(),
+			"databricks_group":                                identity.DataSourceGroup(),
+			"databricks_metastore":                            catalog.DataSourceMetastore(),
+			"databricks_model_version":                        catalog.DataSourceModelVersion(),
+			"databricks_node_type":                            compute.DataSourceNodeType(),
+			"databricks_notebook":                             workspace.DataSourceNotebook(),
+			"databricks_notebook_paths":                       workspace.DataSourceNotebookPaths(),
+			"databricks_provider_shares":                      catalog.DataSourceProviderShares(),
+			"databricks_secret":                               access.DataSourceSecret(),
+			"databricks_spark_version":                        compute.DataSourceSparkVersion(),
+			"databricks_sql_warehouse_events":                 sqlanalytics.DataSourceSQLWarehouseEvents(),
+			"databricks_table":                                catalog.DataSourceTable(),
+			"databricks_tokens":                               identity.DataSourceTokens(),
+			"databricks_user":                                 identity.DataSourceUser(),
+			"databricks_zones":                                compute.DataSourceClusterZones(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"databricks_secret":          access.ResourceSecret(),
@@ -45,20 +62,34 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_sql_permissions": access.ResourceSqlPermissions(),
 			"databricks_ip_access_list":  access.ResourceIPAccessList(),
 
+			"databricks_app": apps.ResourceApp(),
+
+			"databricks_function":  catalog.ResourceFunction(),
+			"databricks_provider":  catalog.ResourceProvider(),
+			"databricks_recipient": catalog.ResourceRecipient(),
+			"databricks_share":     catalog.ResourceShare(),
+
 			"databricks_cluster":        compute.ResourceCluster(),
 			"databricks_cluster_policy": compute.ResourceClusterPolicy(),
 			"databricks_instance_pool":  compute.ResourceInstancePool(),
 			"databricks_job":            compute.ResourceJob(),
+			"databricks_job_run":        compute.ResourceJobRun(),
 			"databricks_pipeline":       compute.ResourcePipeline(),
 
-			"databricks_group":                  identity.ResourceGroup(),
-			"databricks_group_instance_profile": identity.ResourceGroupInstanceProfile(),
-			"databricks_user_instance_profile":  identity.ResourceUserInstanceProfile(),
-			"databricks_instance_profile":       identity.ResourceInstanceProfile(),
-			"databricks_group_member":           identity.ResourceGroupMember(),
-			"databricks_token":                  identity.ResourceToken(),
-			"databricks_user":                   identity.ResourceUser(),
-			"databricks_service_principal":      identity.ResourceServicePrincipal(),
+			"databricks_group":                              identity.ResourceGroup(),
+			"databricks_group_instance_profile":             identity.ResourceGroupInstanceProfile(),
+			"databricks_user_instance_profile":              identity.ResourceUserInstanceProfile(),
+			"databricks_instance_profile":                   identity.ResourceInstanceProfile(),
+			"databricks_group_member":                       identity.ResourceGroupMember(),
+			"databricks_token":                              identity.ResourceToken(),
+			"databricks_token_revocation":                   identity.ResourceTokenRevocation(),
+			"databricks_user":                               identity.ResourceUser(),
+			"databricks_service_principal":                  identity.ResourceServicePrincipal(),
+			"databricks_service_principal_instance_profile": identity.ResourceServicePrincipalInstanceProfile(),
+
+			"databricks_mlflow_experiment": mlflow.ResourceExperiment(),
+			"databricks_mlflow_model":      mlflow.ResourceModel(),
+			"databricks_model_serving":     mlflow.ResourceModelServing(),
 
 			"databricks_mws_customer_managed_keys":   mws.ResourceCustomerManagedKey(),
 			"databricks_mws_credentials":             mws.ResourceCredentials(),
@@ -74,17 +105,34 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_azure_adls_gen2_mount": storage.ResourceAzureAdlsGen2Mount(),
 			"databricks_azure_blob_mount":      storage.ResourceAzureBlobMount(),
 			"databricks_dbfs_file":             storage.ResourceDBFSFile(),
+			"databricks_file":                  storage.ResourceFile(),
+			"databricks_mount":                 storage.ResourceMount(),
+
+			"databricks_automatic_cluster_update_workspace_setting":     settings.ResourceAutomaticClusterUpdateSetting(),
+			"databricks_compliance_security_profile_workspace_setting":  settings.ResourceComplianceSecurityProfileSetting(),
+			"databricks_default_namespace_setting":                      settings.ResourceDefaultNamespaceSetting(),
+			"databricks_disable_legacy_access_setting":                  settings.ResourceDisableLegacyAccessSetting(),
+			"databricks_disable_legacy_dbfs_setting":                    settings.ResourceDisableLegacyDbfsSetting(),
+			"databricks_disable_legacy_features_setting":                settings.ResourceDisableLegacyFeaturesSetting(),
+			"databricks_enhanced_security_monitoring_workspace_setting": settings.ResourceEnhancedSecurityMonitoringSetting(),
+			"databricks_personal_compute_setting":                       settings.ResourcePersonalComputeSetting(),
+			"databricks_restrict_workspace_admins_setting":              settings.ResourceRestrictWorkspaceAdminsSetting(),
 
+			"databricks_alert":             sqlanalytics.ResourceAlert(),
 			"databricks_sql_dashboard":     sqlanalytics.ResourceDashboard(),
 			"databricks_sql_endpoint":      sqlanalytics.ResourceSQLEndpoint(),
 			"databricks_sql_query":         sqlanalytics.ResourceQuery(),
 			"databricks_sql_visualization": sqlanalytics.ResourceVisualization(),
 			"databricks_sql_widget":        sqlanalytics.ResourceWidget(),
 
-			"databricks_directory":          workspace.ResourceDirectory(),
-			"databricks_global_init_script": workspace.ResourceGlobalInitScript(),
-			"databricks_notebook":           workspace.ResourceNotebook(),
-			"databricks_workspace_conf":     workspace.ResourceWorkspaceConf(),
+			"databricks_directory":                 workspace.ResourceDirectory(),
+			"databricks_directory_sync":            workspace.ResourceDirectorySync(),
+			"databricks_git_credential":            workspace.ResourceGitCredential(),
+			"databricks_global_init_script":        workspace.ResourceGlobalInitScript(),
+			"databricks_global_init_scripts_order": workspace.ResourceGlobalInitScriptsOrder(),
+			"databricks_notebook":                  workspace.ResourceNotebook(),
+			"databricks_repo":                      workspace.ResourceRepo(),
+			"databricks_workspace_conf":            workspace.ResourceWorkspaceConf(),
 		},
 		Schema: map[string]*schema.Schema{
 			"host": {
@@ -147,6 +195,39 @@ func DatabricksProvider() *schema.Provider {
 					"token",
 				},
 			},
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_OIDC_TOKEN", nil),
+				Description: "JWT of the workload identity, that is exchanged for Databricks token\n" +
+					"through federation policy.",
+				ConflictsWith: []string{
+					"token",
+					"username",
+					"password",
+				},
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DATABRICKS_AUTH_TYPE", nil),
+				ValidateFunc: validation.StringInSlice([]string{common.AuthTypeGitHubOIDC}, false),
+				Description: "Set to `github-oidc` to exchange ID token of GitHub Actions workflow\n" +
+					"for Databricks token through federation policy.",
+				ConflictsWith: []string{
+					"token",
+					"username",
+					"password",
+					"oidc_token",
+				},
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_CLIENT_ID", nil),
+				Description: "Application ID of the service principal with federation policy for OIDC token exchange",
+			},
 			"azure_workspace_resource_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -306,6 +387,17 @@ func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (int
 		authsUsed["config profile"] = true
 		pc.ConfigFile = v.(string)
 	}
+	if v, ok := d.GetOk("oidc_token"); ok {
+		authsUsed["oidc"] = true
+		pc.OIDCToken = v.(string)
+	}
+	if v, ok := d.GetOk("auth_type"); ok {
+		authsUsed["oidc"] = true
+		pc.AuthType = v.(string)
+	}
+	if v, ok := d.GetOk("client_id"); ok {
+		pc.ClientID = v.(string)
+	}
 	if v, ok := d.GetOk("azure_workspace_resource_id"); ok {
 		authsUsed["azure"] = true
 		pc.AzureAuth.ResourceID = v.(string)
@@ -370,7 +462,7 @@ func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (int
 			strings.Join(authorizationMethodsUsed, " and "))
 	}
 	if err := pc.Configure(); err != nil {
-		return nil, diag.FromErr(err)
+		return nil, common.DiagnosticsFromErr(err)
 	}
 	pc.WithCommandExecutor(func(ctx context.Context, client *common.DatabricksClient) common.CommandExecutor {
 		return compute.NewCommandsAPI(ctx, client)
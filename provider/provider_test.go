@@ -2,12 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -177,6 +181,14 @@ func TestProviderConfigurationOptions(t *testing.T) {
 			},
 			assertError: "More than one authorization method configured: password and token",
 		},
+		{
+			env: map[string]string{
+				"DATABRICKS_HOST":       "x",
+				"DATABRICKS_TOKEN":      "x",
+				"DATABRICKS_OIDC_TOKEN": "x",
+			},
+			assertError: "More than one authorization method configured: oidc and token",
+		},
 		{
 			env: map[string]string{
 				"CONFIG_FILE": "x",
@@ -389,11 +401,56 @@ func TestProvider_InvalidProfileGivesError(t *testing.T) {
 }
 
 func TestAllResourcesMustHaveImport(t *testing.T) {
-	t.Skip("databricks_mws_* are currently not importable")
 	p := DatabricksProvider()
 	for name, r := range p.ResourcesMap {
-		if r.Importer == nil {
-			t.Logf("Missing importer: %s", name)
+		assert.NotNil(t, r.Importer, "Missing importer: %s", name)
+		doc, err := ioutil.ReadFile(fmt.Sprintf("../docs/resources/%s.md",
+			strings.TrimPrefix(name, "databricks_")))
+		if assert.NoError(t, err, "Missing docs: %s", name) {
+			assert.Contains(t, string(doc), "\n## Import\n", "Missing import docs: %s", name)
+		}
+	}
+}
+
+func TestCredentialAttributesAreSensitive(t *testing.T) {
+	p := DatabricksProvider()
+	for resource, path := range map[string][]string{
+		"databricks_cluster":        {"docker_image", "basic_auth", "password"},
+		"databricks_instance_pool":  {"preloaded_docker_image", "basic_auth", "password"},
+		"databricks_job":            {"new_cluster", "docker_image", "basic_auth", "password"},
+		"databricks_model_serving":  {"config", "served_entities", "environment_vars"},
+		"databricks_mount":          {"extra_configs"},
+		"databricks_token":          {"token_value"},
+		"databricks_git_credential": {"personal_access_token"},
+		"databricks_secret":         {"string_value"},
+	} {
+		r, ok := p.ResourcesMap[resource]
+		require.True(t, ok, "Missing resource: %s", resource)
+		s, err := common.SchemaPath(r.Schema, path...)
+		require.NoError(t, err, resource)
+		assert.True(t, s.Sensitive, "%s: %s must be sensitive", resource, strings.Join(path, "."))
+	}
+}
+
+func TestLegacyJobStateUpgradesToCurrentSchema(t *testing.T) {
+	r := DatabricksProvider().ResourcesMap["databricks_job"]
+	var state map[string]interface{}
+	err := json.Unmarshal([]byte(`{"id": "123", "name": "Legacy", "existing_cluster_id": "abc",
+		"notebook_path": "/Shared/Demo", "notebook_base_parameters": {"env": "dev"}}`), &state)
+	require.NoError(t, err)
+	for _, upgrader := range r.StateUpgraders {
+		if upgrader.Version < 2 {
+			continue
 		}
+		state, err = upgrader.Upgrade(context.Background(), state, nil)
+		require.NoError(t, err)
 	}
+	raw, err := json.Marshal(state)
+	require.NoError(t, err)
+	// upgraded state must be decodable with the type of current schema
+	upgraded, err := ctyjson.Unmarshal(raw, r.CoreConfigSchema().ImpliedType())
+	require.NoError(t, err)
+	task := upgraded.GetAttr("notebook_task").Index(cty.NumberIntVal(0))
+	assert.Equal(t, "/Shared/Demo", task.GetAttr("notebook_path").AsString())
+	assert.Equal(t, "dev", task.GetAttr("base_parameters").Index(cty.StringVal("env")).AsString())
 }
@@ -0,0 +1,8 @@
+// Package qa contains unit test harness of the provider. ResourceFixture runs single stage
+// of resource lifecycle against embedded HTTP server, that serves the list of HTTPFixture's
+// and fails the test on any unexpected request.
+//
+// The package is public, so that wrapper providers and custom resources built on top of
+// common.DatabricksClient and common.Resource could be tested in the same way as the
+// resources of this provider.
+package qa
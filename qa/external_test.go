@@ -0,0 +1,89 @@
+package qa_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// widget is a resource of imaginary wrapper provider, that uses only exported API
+type widget struct {
+	ID   string `json:"id,omitempty" tf:"computed"`
+	Name string `json:"name"`
+}
+
+func resourceWidget() *schema.Resource {
+	s := common.StructToSchema(widget{}, func(
+		m map[string]*schema.Schema) map[string]*schema.Schema {
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var w widget
+			if err := common.DataToStructPointer(d, s, &w); err != nil {
+				return err
+			}
+			if err := c.Post(ctx, "/widgets/create", w, &w); err != nil {
+				return err
+			}
+			d.SetId(w.ID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var w widget
+			err := c.Get(ctx, "/widgets/get", map[string]string{
+				"id": d.Id(),
+			}, &w)
+			if err != nil {
+				return err
+			}
+			return common.StructToData(w, s, d)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return c.Post(ctx, "/widgets/delete", map[string]string{
+				"id": d.Id(),
+			}, nil)
+		},
+	}.ToResource()
+}
+
+func TestResourceFixture_OutsideOfProvider(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/widgets/create",
+				ExpectedRequest: widget{
+					Name: "first",
+				},
+				Response: widget{
+					ID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/widgets/get?id=abc",
+				Response: widget{
+					ID:   "abc",
+					Name: "first",
+				},
+			},
+		},
+		Resource: resourceWidget(),
+		Create:   true,
+		HCL:      `name = "first"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "first", d.Get("name"))
+}
+
+func TestResourceFixture_OutsideOfProviderCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, resourceWidget())
+}
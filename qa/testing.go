@@ -15,6 +15,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
@@ -65,6 +66,57 @@ type HTTPFixture struct {
 	ExpectedRequest interface{}
 	ReuseRequest    bool
 	MatchAny        bool
+	// MatchRegex treats Resource as regular expression, that has to match the whole request URI
+	MatchRegex bool
+	// Responses are returned one by one on subsequent calls instead of Response,
+	// e.g. to emulate cluster going from PENDING to RUNNING. Last response is
+	// repeated, when fixture is reused.
+	Responses []interface{}
+	// ExpectedCalls fails the test, if fixture is not called exactly this number of times.
+	// Fixture without ReuseRequest is consumed after this number of calls.
+	ExpectedCalls int
+	// Ordered fixtures have to be called in the same order as they are listed.
+	// Ordered fixture with ReuseRequest doesn't block the following ones.
+	Ordered bool
+}
+
+// matches returns true if fixture is applicable to request
+func (f HTTPFixture) matches(req *http.Request) bool {
+	if f.MatchAny {
+		return true
+	}
+	if req.Method != f.Method {
+		return false
+	}
+	if f.MatchRegex {
+		return regexp.MustCompile("^(?:" + f.Resource + ")$").MatchString(req.RequestURI)
+	}
+	return req.RequestURI == f.Resource
+}
+
+// exhausted returns true if fixture cannot be used anymore after the given number of calls
+func (f HTTPFixture) exhausted(calls int) bool {
+	if f.ReuseRequest {
+		return false
+	}
+	limit := 1
+	if f.ExpectedCalls > 0 {
+		limit = f.ExpectedCalls
+	} else if len(f.Responses) > 0 {
+		limit = len(f.Responses)
+	}
+	return calls >= limit
+}
+
+// response returns the response for the given call number, starting from zero
+func (f HTTPFixture) response(call int) interface{} {
+	if len(f.Responses) == 0 {
+		return f.Response
+	}
+	if call >= len(f.Responses) {
+		call = len(f.Responses) - 1
+	}
+	return f.Responses[call]
 }
 
 // ResourceFixture helps testing resources and commands
@@ -328,44 +380,66 @@ func UnionFixturesLists(fixturesLists ...[]HTTPFixture) (fixtureList []HTTPFixtu
 
 // HttpFixtureClient creates client for emulated HTTP server
 func HttpFixtureClient(t *testing.T, fixtures []HTTPFixture) (client *common.DatabricksClient, server *httptest.Server, err error) {
+	var mu sync.Mutex
+	calls := make([]int, len(fixtures))
+	t.Cleanup(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		for i, fixture := range fixtures {
+			if fixture.ExpectedCalls > 0 {
+				assert.Equal(t, fixture.ExpectedCalls, calls[i],
+					"unexpected number of calls to %s %s", fixture.Method, fixture.Resource)
+			}
+		}
+	})
 	server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
 		found := false
 		for i, fixture := range fixtures {
-			if (req.Method == fixture.Method && req.RequestURI == fixture.Resource) || fixture.MatchAny {
-				if fixture.Status == 0 {
-					rw.WriteHeader(200)
-				} else {
-					rw.WriteHeader(fixture.Status)
+			if fixture.exhausted(calls[i]) || !fixture.matches(req) {
+				continue
+			}
+			if fixture.Ordered {
+				for j := 0; j < i; j++ {
+					if fixtures[j].Ordered && !fixtures[j].ReuseRequest && !fixtures[j].exhausted(calls[j]) {
+						assert.Fail(t, fmt.Sprintf("Out of order request %s %s, expected %s %s first",
+							req.Method, req.RequestURI, fixtures[j].Method, fixtures[j].Resource))
+						t.FailNow()
+					}
 				}
-				if fixture.ExpectedRequest != nil {
-					buf := new(bytes.Buffer)
-					_, err := buf.ReadFrom(req.Body)
-					assert.NoError(t, err, err)
-					jsonStr, err := json.Marshal(fixture.ExpectedRequest)
+			}
+			response := fixture.response(calls[i])
+			calls[i]++
+			if fixture.Status == 0 {
+				rw.WriteHeader(200)
+			} else {
+				rw.WriteHeader(fixture.Status)
+			}
+			if fixture.ExpectedRequest != nil {
+				buf := new(bytes.Buffer)
+				_, err := buf.ReadFrom(req.Body)
+				assert.NoError(t, err, err)
+				jsonStr, err := json.Marshal(fixture.ExpectedRequest)
+				assert.NoError(t, err, err)
+				assert.JSONEq(t, string(jsonStr), buf.String(), "json strings do not match")
+			}
+			if response != nil {
+				if alreadyJSON, ok := response.(string); ok {
+					_, err = rw.Write([]byte(alreadyJSON))
 					assert.NoError(t, err, err)
-					assert.JSONEq(t, string(jsonStr), buf.String(), "json strings do not match")
-				}
-				if fixture.Response != nil {
-					if alreadyJSON, ok := fixture.Response.(string); ok {
-						_, err = rw.Write([]byte(alreadyJSON))
-						assert.NoError(t, err, err)
-					} else {
-						responseBytes, err := json.Marshal(fixture.Response)
-						if err != nil {
-							assert.NoError(t, err, err)
-							t.FailNow()
-						}
-						_, err = rw.Write(responseBytes)
+				} else {
+					responseBytes, err := json.Marshal(response)
+					if err != nil {
 						assert.NoError(t, err, err)
+						t.FailNow()
 					}
+					_, err = rw.Write(responseBytes)
+					assert.NoError(t, err, err)
 				}
-				found = true
-				// Reset the request if it is already used
-				if !fixture.ReuseRequest {
-					fixtures[i] = HTTPFixture{}
-				}
-				break
 			}
+			found = true
+			break
 		}
 		if !found {
 			receivedRequest := map[string]interface{}{}
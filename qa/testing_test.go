@@ -93,6 +93,160 @@ func TestResourceFixture_Hint(t *testing.T) {
 	assert.True(t, t2.Failed())
 }
 
+func TestHttpFixtureClient_MatchRegex(t *testing.T) {
+	HTTPFixturesApply(t, []HTTPFixture{
+		{
+			Method:        "GET",
+			Resource:      `/api/2.0/clusters/get\?cluster_id=\w+`,
+			MatchRegex:    true,
+			ExpectedCalls: 2,
+			Response: HTTPFixture{
+				Method: "SOME",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		for _, id := range []string{"abc", "def"} {
+			var a HTTPFixture
+			err := client.Get(ctx, "/clusters/get", map[string]string{
+				"cluster_id": id,
+			}, &a)
+			assert.NoError(t, err)
+			assert.Equal(t, "SOME", a.Method)
+		}
+	})
+}
+
+func TestHttpFixtureClient_Responses(t *testing.T) {
+	HTTPFixturesApply(t, []HTTPFixture{
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/a/b/c",
+			ReuseRequest: true,
+			Responses: []interface{}{
+				HTTPFixture{Method: "PENDING"},
+				HTTPFixture{Method: "RUNNING"},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		for _, expected := range []string{"PENDING", "RUNNING", "RUNNING"} {
+			var a HTTPFixture
+			err := client.Get(ctx, "/a/b/c", nil, &a)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, a.Method)
+		}
+	})
+}
+
+func TestHttpFixtureClient_ResponsesAreConsumed(t *testing.T) {
+	t2 := testing.T{}
+	client, server, err := HttpFixtureClient(&t2, []HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/a/b/c",
+			Responses: []interface{}{
+				HTTPFixture{Method: "PENDING"},
+				HTTPFixture{Method: "RUNNING"},
+			},
+		},
+	})
+	defer server.Close()
+	assert.NoError(t, err)
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		err = client.Get(ctx, "/a/b/c", nil, nil)
+		assert.NoError(t, err)
+	}
+	assert.False(t, t2.Failed())
+	err = client.Get(ctx, "/a/b/c", nil, nil)
+	assert.Error(t, err)
+	assert.True(t, t2.Failed())
+}
+
+func TestHttpFixtureClient_Ordered(t *testing.T) {
+	HTTPFixturesApply(t, []HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/clusters/start",
+			Ordered:  true,
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/clusters/events",
+			ReuseRequest: true,
+			Ordered:      true,
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/clusters/delete",
+			Ordered:  true,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		assert.NoError(t, client.Post(ctx, "/clusters/start", nil, nil))
+		assert.NoError(t, client.Get(ctx, "/clusters/events", nil, nil))
+		assert.NoError(t, client.Get(ctx, "/clusters/events", nil, nil))
+		assert.NoError(t, client.Post(ctx, "/clusters/delete", nil, nil))
+	})
+}
+
+func TestHttpFixtureClient_OutOfOrder(t *testing.T) {
+	t2 := testing.T{}
+	client, server, err := HttpFixtureClient(&t2, []HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/clusters/start",
+			Ordered:  true,
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/clusters/delete",
+			Ordered:  true,
+		},
+	})
+	defer server.Close()
+	assert.NoError(t, err)
+	err = client.Post(context.Background(), "/clusters/delete", nil, nil)
+	assert.Error(t, err)
+	assert.True(t, t2.Failed())
+}
+
+func TestHttpFixtureClient_PollingScenario(t *testing.T) {
+	HTTPFixturesApply(t, []HTTPFixture{
+		{
+			Method:     "GET",
+			Resource:   `/api/2.0/clusters/get\?cluster_id=\w+`,
+			MatchRegex: true,
+			Ordered:    true,
+			Responses: []interface{}{
+				map[string]string{"state": "TERMINATING"},
+				map[string]string{"state": "TERMINATED"},
+			},
+			ExpectedCalls: 2,
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/clusters/start",
+			Ordered:  true,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/get?cluster_id=abc",
+			Ordered:  true,
+			Response: map[string]string{"state": "RUNNING"},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		query := map[string]string{"cluster_id": "abc"}
+		for _, expected := range []string{"TERMINATING", "TERMINATED"} {
+			var info map[string]string
+			assert.NoError(t, client.Get(ctx, "/clusters/get", query, &info))
+			assert.Equal(t, expected, info["state"])
+		}
+		assert.NoError(t, client.Post(ctx, "/clusters/start", query, nil))
+		var info map[string]string
+		assert.NoError(t, client.Get(ctx, "/clusters/get", query, &info))
+		assert.Equal(t, "RUNNING", info["state"])
+	})
+}
+
 var noopResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"dummy": {
@@ -0,0 +1,240 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultSettingName is the only name, that typed settings currently have
+const defaultSettingName = "default"
+
+// settingDefinition describes a typed setting, that is managed through generic Settings API.
+// New setting needs only a struct for its value and a definition, like:
+//
+//	settingDefinition{
+//		settingType: "default_namespace_ws",
+//		fieldName:   "namespace",
+//		value:       StringMessage{},
+//	}
+type settingDefinition struct {
+	// type of the setting in API path, e.g. `default_namespace_ws`
+	settingType string
+	// name of the typed field in setting payload, which also becomes a block in HCL
+	fieldName string
+	// zero value of the struct, that describes the typed field
+	value interface{}
+	// account-level settings require `account_id` and are managed through accounts API
+	account bool
+	// settings, that can't be reverted once enabled, are only removed from the state on delete
+	retainOnDelete bool
+	// settings without delete operation are updated with this value on delete, e.g. to disable a feature
+	disabledValue interface{}
+	// optional schema customization of the typed field
+	customize func(map[string]*schema.Schema) map[string]*schema.Schema
+}
+
+// payloadType returns the type of setting, as it's sent to and received from API:
+// etag and setting name, followed by the typed field
+func (sd settingDefinition) payloadType() reflect.Type {
+	return reflect.StructOf([]reflect.StructField{
+		{
+			Name: "Etag",
+			Type: reflect.TypeOf(""),
+			Tag:  `json:"etag,omitempty" tf:"computed"`,
+		},
+		{
+			Name: "SettingName",
+			Type: reflect.TypeOf(""),
+			Tag:  `json:"setting_name,omitempty" tf:"computed"`,
+		},
+		{
+			Name: "Value",
+			Type: reflect.PtrTo(reflect.TypeOf(sd.value)),
+			Tag:  reflect.StructTag(fmt.Sprintf(`json:"%s"`, sd.fieldName)),
+		},
+	})
+}
+
+// fieldMask lists all fields of the typed setting, e.g. `namespace.value`
+func (sd settingDefinition) fieldMask() string {
+	var fields []string
+	rt := reflect.TypeOf(sd.value)
+	for i := 0; i < rt.NumField(); i++ {
+		name := strings.Split(rt.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		fields = append(fields, sd.fieldName+"."+name)
+	}
+	return strings.Join(fields, ",")
+}
+
+func newSettingsAPI(ctx context.Context, m interface{}) settingsAPI {
+	return settingsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// settingsAPI exposes generic Settings API, that is shared by workspace and account settings
+type settingsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func settingPath(sd settingDefinition, accountID string) string {
+	path := fmt.Sprintf("/settings/types/%s/names/%s", sd.settingType, defaultSettingName)
+	if sd.account {
+		return fmt.Sprintf("/accounts/%s%s", accountID, path)
+	}
+	return path
+}
+
+// read fetches setting payload into pointer to payload type. Empty etag returns the latest version.
+func (a settingsAPI) read(path, etag string, payload interface{}) error {
+	return a.client.Get(a.context, path, map[string]string{
+		"etag": etag,
+	}, payload)
+}
+
+// update replaces setting value, if etag matches the current version. Fields,
+// that are not in the field mask, are left unchanged.
+func (a settingsAPI) update(path, fieldMask string, payload interface{}) error {
+	return a.client.Patch(a.context, path, map[string]interface{}{
+		"allow_missing": true,
+		"field_mask":    fieldMask,
+		"setting":       payload,
+	})
+}
+
+// delete reverts setting to its default value
+func (a settingsAPI) delete(path, etag string) error {
+	return a.client.Delete(a.context, path+"?etag="+url.QueryEscape(etag), nil)
+}
+
+// latestEtag returns etag of the current version of setting
+func (a settingsAPI) latestEtag(path string) (string, error) {
+	var latest struct {
+		Etag string `json:"etag"`
+	}
+	err := a.read(path, "", &latest)
+	return latest.Etag, err
+}
+
+// isEtagConflict returns true, when setting was changed concurrently and etag has to be refreshed
+func isEtagConflict(err error) bool {
+	apiErr, ok := err.(common.APIError)
+	if !ok {
+		return false
+	}
+	return apiErr.StatusCode == 409 || apiErr.ErrorCode == "RESOURCE_CONFLICT" ||
+		apiErr.ErrorCode == "ABORTED"
+}
+
+// makeSettingResource creates Terraform resource for typed setting definition
+func makeSettingResource(sd settingDefinition) *schema.Resource {
+	payloadType := sd.payloadType()
+	s := common.StructToSchema(reflect.New(payloadType).Elem().Interface(),
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			if sd.customize != nil {
+				elem := m[sd.fieldName].Elem.(*schema.Resource)
+				elem.Schema = sd.customize(elem.Schema)
+			}
+			if sd.account {
+				m["account_id"] = &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				}
+			}
+			return m
+		})
+	accountID := func(d *schema.ResourceData) string {
+		if !sd.account {
+			return ""
+		}
+		if v := d.Get("account_id").(string); v != "" {
+			return v
+		}
+		// account-level settings are identified by account, e.g. on import
+		return d.Id()
+	}
+	// patch updates setting with the payload and retries once with refreshed etag on conflict
+	patch := func(api settingsAPI, path string, payload reflect.Value) error {
+		payload.Elem().FieldByName("SettingName").SetString(defaultSettingName)
+		err := api.update(path, sd.fieldMask(), payload.Interface())
+		if isEtagConflict(err) {
+			// setting was changed outside of Terraform, so we refresh the etag and try once again
+			log.Printf("[INFO] Setting %s has changed, refreshing its etag", sd.settingType)
+			var etag string
+			if etag, err = api.latestEtag(path); err != nil {
+				return err
+			}
+			payload.Elem().FieldByName("Etag").SetString(etag)
+			err = api.update(path, sd.fieldMask(), payload.Interface())
+		}
+		return err
+	}
+	update := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		payload := reflect.New(payloadType)
+		if err := common.DataToStructPointer(d, s, payload.Interface()); err != nil {
+			return err
+		}
+		err := patch(newSettingsAPI(ctx, c), settingPath(sd, accountID(d)), payload)
+		if err != nil {
+			return err
+		}
+		if sd.account {
+			d.SetId(accountID(d))
+			return nil
+		}
+		d.SetId(defaultSettingName)
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: update,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			payload := reflect.New(payloadType)
+			err := newSettingsAPI(ctx, c).read(settingPath(sd, accountID(d)), "", payload.Interface())
+			if err != nil {
+				return err
+			}
+			if sd.account {
+				d.Set("account_id", accountID(d))
+			}
+			return common.StructToData(payload.Elem().Interface(), s, d)
+		},
+		Update: update,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if sd.retainOnDelete {
+				log.Printf("[WARN] Setting %s can't be reverted and is only removed from the state", sd.settingType)
+				return nil
+			}
+			api := newSettingsAPI(ctx, c)
+			path := settingPath(sd, accountID(d))
+			if sd.disabledValue != nil {
+				payload := reflect.New(payloadType)
+				payload.Elem().FieldByName("Etag").SetString(d.Get("etag").(string))
+				value := reflect.New(reflect.TypeOf(sd.disabledValue))
+				value.Elem().Set(reflect.ValueOf(sd.disabledValue))
+				payload.Elem().FieldByName("Value").Set(value)
+				return patch(api, path, payload)
+			}
+			err := api.delete(path, d.Get("etag").(string))
+			if isEtagConflict(err) {
+				etag, err := api.latestEtag(path)
+				if err != nil {
+					return err
+				}
+				return api.delete(path, etag)
+			}
+			return err
+		},
+	}.ToResource()
+}
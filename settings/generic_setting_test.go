@@ -0,0 +1,670 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingFieldMask(t *testing.T) {
+	assert.Equal(t, "namespace.value", settingDefinition{
+		fieldName: "namespace",
+		value:     StringMessage{},
+	}.fieldMask())
+}
+
+func TestResourceDefaultNamespaceSettingCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceDefaultNamespaceSetting(), "default")
+}
+
+func TestResourceDefaultNamespaceSettingCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask":    "namespace.value",
+					"setting": map[string]interface{}{
+						"setting_name": "default",
+						"namespace": map[string]interface{}{
+							"value": "main",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"namespace": map[string]interface{}{
+						"value": "main",
+					},
+				},
+			},
+		},
+		Resource: ResourceDefaultNamespaceSetting(),
+		Create:   true,
+		HCL: `
+		namespace {
+			value = "main"
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "default", d.Id())
+	assert.Equal(t, "etag1", d.Get("etag"))
+	assert.Equal(t, "main", d.Get("namespace.0.value"))
+}
+
+func TestResourceDefaultNamespaceSettingUpdate_EtagConflict(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask":    "namespace.value",
+					"setting": map[string]interface{}{
+						"etag":         "etag1",
+						"setting_name": "default",
+						"namespace": map[string]interface{}{
+							"value": "sandbox",
+						},
+					},
+				},
+				Status: 409,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_CONFLICT",
+					Message:   "etag does not match",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag2",
+					"setting_name": "default",
+					"namespace": map[string]interface{}{
+						"value": "other",
+					},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask":    "namespace.value",
+					"setting": map[string]interface{}{
+						"etag":         "etag2",
+						"setting_name": "default",
+						"namespace": map[string]interface{}{
+							"value": "sandbox",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag3",
+					"setting_name": "default",
+					"namespace": map[string]interface{}{
+						"value": "sandbox",
+					},
+				},
+			},
+		},
+		Resource: ResourceDefaultNamespaceSetting(),
+		Update:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag":              "etag1",
+			"setting_name":      "default",
+			"namespace.#":       "1",
+			"namespace.0.value": "main",
+		},
+		HCL: `
+		namespace {
+			value = "sandbox"
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "etag3", d.Get("etag"))
+	assert.Equal(t, "sandbox", d.Get("namespace.0.value"))
+}
+
+func TestResourceDefaultNamespaceSettingDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?etag=etag1",
+				Status:   409,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_CONFLICT",
+					Message:   "etag does not match",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?",
+				Response: map[string]interface{}{
+					"etag": "etag2",
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?etag=etag2",
+			},
+		},
+		Resource: ResourceDefaultNamespaceSetting(),
+		Delete:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag":              "etag1",
+			"namespace.#":       "1",
+			"namespace.0.value": "main",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceRestrictWorkspaceAdminsSettingInvalidStatus(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceRestrictWorkspaceAdminsSetting(),
+		Create:   true,
+		HCL: `
+		restrict_workspace_admins {
+			status = "RESTRICT_EVERYTHING"
+		}
+		`,
+	}.Apply(t)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "got RESTRICT_EVERYTHING")
+}
+
+func TestResourcePersonalComputeSettingRead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/settings/types/dcp_acct_enable/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"personal_compute": map[string]interface{}{
+						"value": "DELEGATE",
+					},
+				},
+			},
+		},
+		Resource: ResourcePersonalComputeSetting(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+		HCL: `
+		account_id = "abc"
+		personal_compute {
+			value = "DELEGATE"
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "DELEGATE", d.Get("personal_compute.0.value"))
+	assert.Equal(t, "abc", d.Get("account_id"))
+}
+
+func TestResourcePersonalComputeSettingCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/accounts/abc/settings/types/dcp_acct_enable/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask":    "personal_compute.value",
+					"setting": map[string]interface{}{
+						"setting_name": "default",
+						"personal_compute": map[string]interface{}{
+							"value": "ON",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/settings/types/dcp_acct_enable/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"personal_compute": map[string]interface{}{
+						"value": "ON",
+					},
+				},
+			},
+		},
+		Resource: ResourcePersonalComputeSetting(),
+		Create:   true,
+		HCL: `
+		account_id = "abc"
+		personal_compute {
+			value = "ON"
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+}
+
+func TestResourcePersonalComputeSettingImport(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/settings/types/dcp_acct_enable/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"personal_compute": map[string]interface{}{
+						"value": "DELEGATE",
+					},
+				},
+			},
+		},
+		Resource: ResourcePersonalComputeSetting(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Get("account_id"))
+	assert.Equal(t, "DELEGATE", d.Get("personal_compute.0.value"))
+}
+
+func TestResourceAutomaticClusterUpdateSettingCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/automatic_cluster_update/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask": "automatic_cluster_update_workspace.enabled," +
+						"automatic_cluster_update_workspace.restart_even_if_no_updates_available," +
+						"automatic_cluster_update_workspace.maintenance_window",
+					"setting": map[string]interface{}{
+						"setting_name": "default",
+						"automatic_cluster_update_workspace": map[string]interface{}{
+							"enabled": true,
+							"maintenance_window": map[string]interface{}{
+								"week_day_based_schedule": map[string]interface{}{
+									"day_of_week": "SUNDAY",
+									"frequency":   "FIRST_AND_THIRD_OF_MONTH",
+									"window_start_time": map[string]interface{}{
+										"hours":   2,
+										"minutes": 30,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/automatic_cluster_update/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"automatic_cluster_update_workspace": map[string]interface{}{
+						"enabled":    true,
+						"can_toggle": true,
+						"maintenance_window": map[string]interface{}{
+							"week_day_based_schedule": map[string]interface{}{
+								"day_of_week": "SUNDAY",
+								"frequency":   "FIRST_AND_THIRD_OF_MONTH",
+								"window_start_time": map[string]interface{}{
+									"hours":   2,
+									"minutes": 30,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceAutomaticClusterUpdateSetting(),
+		Create:   true,
+		HCL: `
+		automatic_cluster_update_workspace {
+			enabled = true
+			maintenance_window {
+				week_day_based_schedule {
+					day_of_week = "SUNDAY"
+					frequency = "FIRST_AND_THIRD_OF_MONTH"
+					window_start_time {
+						hours = 2
+						minutes = 30
+					}
+				}
+			}
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "etag1", d.Get("etag"))
+	assert.Equal(t, 30, d.Get("automatic_cluster_update_workspace.0."+
+		"maintenance_window.0.week_day_based_schedule.0.window_start_time.0.minutes"))
+}
+
+func TestResourceAutomaticClusterUpdateSettingInvalidHours(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceAutomaticClusterUpdateSetting(),
+		Create:   true,
+		HCL: `
+		automatic_cluster_update_workspace {
+			enabled = true
+			maintenance_window {
+				week_day_based_schedule {
+					day_of_week = "SUNDAY"
+					frequency = "EVERY_WEEK"
+					window_start_time {
+						hours = 25
+						minutes = 0
+					}
+				}
+			}
+		}
+		`,
+	}.Apply(t)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "to be in the range (0 - 23), got 25")
+}
+
+func TestResourceComplianceSecurityProfileSettingCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/shield_csp_enablement_ac/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask": "compliance_security_profile_workspace.is_enabled," +
+						"compliance_security_profile_workspace.compliance_standards",
+					"setting": map[string]interface{}{
+						"setting_name": "default",
+						"compliance_security_profile_workspace": map[string]interface{}{
+							"is_enabled":           true,
+							"compliance_standards": []interface{}{"HIPAA"},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/shield_csp_enablement_ac/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"compliance_security_profile_workspace": map[string]interface{}{
+						"is_enabled":           true,
+						"compliance_standards": []interface{}{"HIPAA"},
+					},
+				},
+			},
+		},
+		Resource: ResourceComplianceSecurityProfileSetting(),
+		Create:   true,
+		HCL: `
+		compliance_security_profile_workspace {
+			is_enabled = true
+			compliance_standards = ["HIPAA"]
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "etag1", d.Get("etag"))
+	assert.Equal(t, 1, d.Get("compliance_security_profile_workspace.0.compliance_standards.#"))
+}
+
+func TestResourceComplianceSecurityProfileSettingDeleteRetains(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceComplianceSecurityProfileSetting(),
+		Delete:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag": "etag1",
+			"compliance_security_profile_workspace.#":            "1",
+			"compliance_security_profile_workspace.0.is_enabled": "true",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceComplianceSecurityProfileSettingInvalidStandard(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource: ResourceComplianceSecurityProfileSetting(),
+		Create:   true,
+		HCL: `
+		compliance_security_profile_workspace {
+			is_enabled = true
+			compliance_standards = ["SOX"]
+		}
+		`,
+	}.Apply(t)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected compliance_security_profile_workspace.0.compliance_standards")
+}
+
+func TestResourceEnhancedSecurityMonitoringSettingCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/shield_esm_enablement_ac/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask":    "enhanced_security_monitoring_workspace.is_enabled",
+					"setting": map[string]interface{}{
+						"setting_name": "default",
+						"enhanced_security_monitoring_workspace": map[string]interface{}{
+							"is_enabled": true,
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/shield_esm_enablement_ac/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"enhanced_security_monitoring_workspace": map[string]interface{}{
+						"is_enabled": true,
+					},
+				},
+			},
+		},
+		Resource: ResourceEnhancedSecurityMonitoringSetting(),
+		Create:   true,
+		HCL: `
+		enhanced_security_monitoring_workspace {
+			is_enabled = true
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "etag1", d.Get("etag"))
+}
+
+func TestResourceEnhancedSecurityMonitoringSettingReadDrift(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/shield_esm_enablement_ac/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag2",
+					"setting_name": "default",
+					"enhanced_security_monitoring_workspace": map[string]interface{}{
+						"is_enabled": false,
+					},
+				},
+			},
+		},
+		Resource: ResourceEnhancedSecurityMonitoringSetting(),
+		Read:     true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag": "etag1",
+			"enhanced_security_monitoring_workspace.#":            "1",
+			"enhanced_security_monitoring_workspace.0.is_enabled": "true",
+		},
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "etag2", d.Get("etag"))
+	assert.Equal(t, false, d.Get("enhanced_security_monitoring_workspace.0.is_enabled"))
+}
+
+func TestResourceEnhancedSecurityMonitoringSettingDeleteDisables(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/shield_esm_enablement_ac/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask":    "enhanced_security_monitoring_workspace.is_enabled",
+					"setting": map[string]interface{}{
+						"etag":         "etag1",
+						"setting_name": "default",
+						"enhanced_security_monitoring_workspace": map[string]interface{}{
+							"is_enabled": false,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceEnhancedSecurityMonitoringSetting(),
+		Delete:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag": "etag1",
+			"enhanced_security_monitoring_workspace.#":            "1",
+			"enhanced_security_monitoring_workspace.0.is_enabled": "true",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceDisableLegacyDbfsSettingCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/disable_legacy_dbfs/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask":    "disable_legacy_dbfs.value",
+					"setting": map[string]interface{}{
+						"setting_name": "default",
+						"disable_legacy_dbfs": map[string]interface{}{
+							"value": true,
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/disable_legacy_dbfs/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"disable_legacy_dbfs": map[string]interface{}{
+						"value": true,
+					},
+				},
+			},
+		},
+		Resource: ResourceDisableLegacyDbfsSetting(),
+		Create:   true,
+		HCL: `
+		disable_legacy_dbfs {
+			value = true
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "default", d.Id())
+	assert.Equal(t, true, d.Get("disable_legacy_dbfs.0.value"))
+}
+
+func TestResourceDisableLegacyAccessSettingDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/settings/types/disable_legacy_access/names/default?etag=etag1",
+			},
+		},
+		Resource: ResourceDisableLegacyAccessSetting(),
+		Delete:   true,
+		ID:       "default",
+		InstanceState: map[string]string{
+			"etag":                          "etag1",
+			"disable_legacy_access.#":       "1",
+			"disable_legacy_access.0.value": "true",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceDisableLegacyFeaturesSettingCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/accounts/abc/settings/types/disable_legacy_features/names/default",
+				ExpectedRequest: map[string]interface{}{
+					"allow_missing": true,
+					"field_mask":    "disable_legacy_features.value",
+					"setting": map[string]interface{}{
+						"setting_name": "default",
+						"disable_legacy_features": map[string]interface{}{
+							"value": true,
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/settings/types/disable_legacy_features/names/default?",
+				Response: map[string]interface{}{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"disable_legacy_features": map[string]interface{}{
+						"value": true,
+					},
+				},
+			},
+		},
+		Resource: ResourceDisableLegacyFeaturesSetting(),
+		Create:   true,
+		HCL: `
+		account_id = "abc"
+		disable_legacy_features {
+			value = true
+		}
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "etag1", d.Get("etag"))
+}
@@ -0,0 +1,60 @@
+package settings
+
+import (
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// WindowStartTime is the time of the day in workspace timezone, when maintenance window starts
+type WindowStartTime struct {
+	Hours   int `json:"hours"`
+	Minutes int `json:"minutes"`
+}
+
+// WeekDayBasedSchedule repeats maintenance window on the given day of week
+type WeekDayBasedSchedule struct {
+	DayOfWeek       string           `json:"day_of_week"`
+	Frequency       string           `json:"frequency"`
+	WindowStartTime *WindowStartTime `json:"window_start_time"`
+}
+
+// MaintenanceWindow is the schedule, when clusters could be restarted
+type MaintenanceWindow struct {
+	WeekDayBasedSchedule *WeekDayBasedSchedule `json:"week_day_based_schedule"`
+}
+
+// ClusterAutoRestartMessage configures automatic restarts of long-running clusters to apply updates
+type ClusterAutoRestartMessage struct {
+	Enabled                         bool               `json:"enabled"`
+	RestartEvenIfNoUpdatesAvailable bool               `json:"restart_even_if_no_updates_available,omitempty"`
+	MaintenanceWindow               *MaintenanceWindow `json:"maintenance_window,omitempty"`
+}
+
+// ResourceAutomaticClusterUpdateSetting manages automatic cluster update of the workspace
+func ResourceAutomaticClusterUpdateSetting() *schema.Resource {
+	return makeSettingResource(settingDefinition{
+		settingType: "automatic_cluster_update",
+		fieldName:   "automatic_cluster_update_workspace",
+		value:       ClusterAutoRestartMessage{},
+		customize: func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			schedule := func(field ...string) *schema.Schema {
+				path := append([]string{"maintenance_window", "week_day_based_schedule"}, field...)
+				v, err := common.SchemaPath(m, path...)
+				if err != nil {
+					panic(err)
+				}
+				return v
+			}
+			schedule("day_of_week").ValidateFunc = validation.StringInSlice([]string{
+				"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}, false)
+			schedule("frequency").ValidateFunc = validation.StringInSlice([]string{
+				"FIRST_OF_MONTH", "SECOND_OF_MONTH", "THIRD_OF_MONTH", "FOURTH_OF_MONTH",
+				"FIRST_AND_THIRD_OF_MONTH", "SECOND_AND_FOURTH_OF_MONTH", "EVERY_WEEK"}, false)
+			schedule("window_start_time", "hours").ValidateFunc = validation.IntBetween(0, 23)
+			schedule("window_start_time", "minutes").ValidateFunc = validation.IntBetween(0, 59)
+			return m
+		},
+	})
+}
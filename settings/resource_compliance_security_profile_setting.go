@@ -0,0 +1,30 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ComplianceSecurityProfile enables enhanced security controls for regulated workloads
+type ComplianceSecurityProfile struct {
+	IsEnabled           bool     `json:"is_enabled"`
+	ComplianceStandards []string `json:"compliance_standards,omitempty" tf:"slice_set"`
+}
+
+// ResourceComplianceSecurityProfileSetting manages compliance security profile of the workspace
+func ResourceComplianceSecurityProfileSetting() *schema.Resource {
+	return makeSettingResource(settingDefinition{
+		settingType: "shield_csp_enablement_ac",
+		fieldName:   "compliance_security_profile_workspace",
+		value:       ComplianceSecurityProfile{},
+		// compliance security profile can't be disabled once it's enabled
+		retainOnDelete: true,
+		customize: func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["compliance_standards"].Elem.(*schema.Schema).ValidateFunc = validation.StringInSlice([]string{
+				"NONE", "HIPAA", "PCI_DSS", "FEDRAMP_MODERATE", "IRAP_PROTECTED", "ISMAP",
+				"ITAR_EAR", "CYBER_ESSENTIAL_PLUS", "CANADA_PROTECTED_B", "HITRUST", "K_FSI",
+				"GERMANY_C5"}, false)
+			return m
+		},
+	})
+}
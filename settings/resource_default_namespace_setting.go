@@ -0,0 +1,19 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StringMessage is a setting value, that consists of a single string
+type StringMessage struct {
+	Value string `json:"value"`
+}
+
+// ResourceDefaultNamespaceSetting manages default catalog for queries without fully qualified names
+func ResourceDefaultNamespaceSetting() *schema.Resource {
+	return makeSettingResource(settingDefinition{
+		settingType: "default_namespace_ws",
+		fieldName:   "namespace",
+		value:       StringMessage{},
+	})
+}
@@ -0,0 +1,15 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceDisableLegacyAccessSetting manages access to Hive metastore, DBFS mounts
+// and no-isolation clusters of the workspace
+func ResourceDisableLegacyAccessSetting() *schema.Resource {
+	return makeSettingResource(settingDefinition{
+		settingType: "disable_legacy_access",
+		fieldName:   "disable_legacy_access",
+		value:       BooleanMessage{},
+	})
+}
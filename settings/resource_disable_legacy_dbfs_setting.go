@@ -0,0 +1,19 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BooleanMessage is a setting value, that consists of a single flag
+type BooleanMessage struct {
+	Value bool `json:"value"`
+}
+
+// ResourceDisableLegacyDbfsSetting manages access to DBFS root and mounts of the workspace
+func ResourceDisableLegacyDbfsSetting() *schema.Resource {
+	return makeSettingResource(settingDefinition{
+		settingType: "disable_legacy_dbfs",
+		fieldName:   "disable_legacy_dbfs",
+		value:       BooleanMessage{},
+	})
+}
@@ -0,0 +1,16 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceDisableLegacyFeaturesSetting manages legacy features of workspaces, that
+// are created in the account after the setting is enabled
+func ResourceDisableLegacyFeaturesSetting() *schema.Resource {
+	return makeSettingResource(settingDefinition{
+		settingType: "disable_legacy_features",
+		fieldName:   "disable_legacy_features",
+		value:       BooleanMessage{},
+		account:     true,
+	})
+}
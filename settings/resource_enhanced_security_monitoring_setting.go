@@ -0,0 +1,20 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// EnhancedSecurityMonitoring enables additional security monitoring agents on compute
+type EnhancedSecurityMonitoring struct {
+	IsEnabled bool `json:"is_enabled"`
+}
+
+// ResourceEnhancedSecurityMonitoringSetting manages enhanced security monitoring of the workspace
+func ResourceEnhancedSecurityMonitoringSetting() *schema.Resource {
+	return makeSettingResource(settingDefinition{
+		settingType:   "shield_esm_enablement_ac",
+		fieldName:     "enhanced_security_monitoring_workspace",
+		value:         EnhancedSecurityMonitoring{},
+		disabledValue: EnhancedSecurityMonitoring{IsEnabled: false},
+	})
+}
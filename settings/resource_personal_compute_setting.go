@@ -0,0 +1,20 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourcePersonalComputeSetting manages account-wide availability of Personal Compute policy
+func ResourcePersonalComputeSetting() *schema.Resource {
+	return makeSettingResource(settingDefinition{
+		settingType: "dcp_acct_enable",
+		fieldName:   "personal_compute",
+		value:       StringMessage{},
+		account:     true,
+		customize: func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["value"].ValidateFunc = validation.StringInSlice([]string{"ON", "DELEGATE"}, false)
+			return m
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// RestrictWorkspaceAdminsMessage limits what workspace admins can do with tokens and job identities
+type RestrictWorkspaceAdminsMessage struct {
+	Status string `json:"status"`
+}
+
+// ResourceRestrictWorkspaceAdminsSetting manages restrictions of workspace admins
+func ResourceRestrictWorkspaceAdminsSetting() *schema.Resource {
+	return makeSettingResource(settingDefinition{
+		settingType: "restrict_workspace_admins",
+		fieldName:   "restrict_workspace_admins",
+		value:       RestrictWorkspaceAdminsMessage{},
+		customize: func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["status"].ValidateFunc = validation.StringInSlice([]string{
+				"ALLOW_ALL", "RESTRICT_TOKENS_AND_JOB_RUN_AS"}, false)
+			return m
+		},
+	})
+}
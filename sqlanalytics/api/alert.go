@@ -0,0 +1,64 @@
+package api
+
+// Alert ...
+type Alert struct {
+	ID                 string         `json:"id,omitempty"`
+	DisplayName        string         `json:"display_name"`
+	QueryID            string         `json:"query_id"`
+	Condition          AlertCondition `json:"condition"`
+	CustomSubject      string         `json:"custom_subject,omitempty"`
+	CustomBody         string         `json:"custom_body,omitempty"`
+	NotifyOnOk         bool           `json:"notify_on_ok,omitempty"`
+	SecondsToRetrigger int            `json:"seconds_to_retrigger,omitempty"`
+	ParentPath         string         `json:"parent_path,omitempty"`
+	OwnerUserName      string         `json:"owner_user_name,omitempty"`
+	State              string         `json:"state,omitempty"`
+	LifecycleState     string         `json:"lifecycle_state,omitempty"`
+}
+
+// AlertCondition ...
+type AlertCondition struct {
+	Op               string          `json:"op"`
+	Operand          AlertOperand    `json:"operand"`
+	Threshold        *AlertThreshold `json:"threshold,omitempty"`
+	EmptyResultState string          `json:"empty_result_state,omitempty"`
+}
+
+// AlertOperand ...
+type AlertOperand struct {
+	Column AlertOperandColumn `json:"column"`
+}
+
+// AlertOperandColumn ...
+type AlertOperandColumn struct {
+	Name string `json:"name"`
+}
+
+// AlertThreshold ...
+type AlertThreshold struct {
+	Value AlertValue `json:"value"`
+}
+
+// AlertValue holds exactly one of the typed threshold values.
+type AlertValue struct {
+	StringValue *string  `json:"string_value,omitempty"`
+	DoubleValue *float64 `json:"double_value,omitempty"`
+	BoolValue   *bool    `json:"bool_value,omitempty"`
+}
+
+// AlertUpdate is the body of partial alert update request.
+type AlertUpdate struct {
+	UpdateMask string `json:"update_mask"`
+	Alert      *Alert `json:"alert"`
+}
+
+// AlertCreate is the body of alert creation request.
+type AlertCreate struct {
+	Alert *Alert `json:"alert"`
+}
+
+// AlertList is a page of alerts.
+type AlertList struct {
+	Results       []Alert `json:"results,omitempty"`
+	NextPageToken string  `json:"next_page_token,omitempty"`
+}
@@ -0,0 +1,163 @@
+package sqlanalytics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/sqlanalytics/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AlertDestination is a subscription of the job, that evaluates the alert
+type AlertDestination struct {
+	JobID         string `json:"job_id,omitempty" tf:"computed"`
+	DestinationID string `json:"destination_id,omitempty" tf:"computed"`
+	UserName      string `json:"user_name,omitempty" tf:"computed"`
+}
+
+type alertData struct {
+	ID                 string                `json:"id,omitempty" tf:"computed"`
+	DisplayName        string                `json:"display_name,omitempty" tf:"computed"`
+	QueryID            string                `json:"query_id,omitempty" tf:"computed"`
+	Condition          *AlertConditionEntity `json:"condition,omitempty" tf:"computed"`
+	CustomSubject      string                `json:"custom_subject,omitempty" tf:"computed"`
+	CustomBody         string                `json:"custom_body,omitempty" tf:"computed"`
+	NotifyOnOk         bool                  `json:"notify_on_ok,omitempty" tf:"computed"`
+	SecondsToRetrigger int                   `json:"seconds_to_retrigger,omitempty" tf:"computed"`
+	ParentPath         string                `json:"parent_path,omitempty" tf:"computed"`
+	OwnerUserName      string                `json:"owner_user_name,omitempty" tf:"computed"`
+	State              string                `json:"state,omitempty" tf:"computed"`
+	Destinations       []AlertDestination    `json:"destinations,omitempty" tf:"computed"`
+}
+
+type alertJobList struct {
+	Jobs    []alertJob `json:"jobs,omitempty"`
+	HasMore bool       `json:"has_more,omitempty"`
+}
+
+// ListSchedules returns all jobs with SQL alert tasks, that evaluate the alert
+func (a AlertAPI) ListSchedules(alertID string) (jobs []alertJob, err error) {
+	offset := 0
+	for {
+		var page alertJobList
+		err = a.client.Get(a.context, "/jobs/list", map[string]interface{}{
+			"expand_tasks": true,
+			"limit":        25,
+			"offset":       offset,
+		}, &page)
+		if err != nil {
+			return
+		}
+		for _, job := range page.Jobs {
+			for _, task := range job.Settings.Tasks {
+				if task.SQLTask.Alert.AlertID == alertID {
+					jobs = append(jobs, job)
+					break
+				}
+			}
+		}
+		if !page.HasMore || len(page.Jobs) == 0 {
+			return
+		}
+		offset += len(page.Jobs)
+	}
+}
+
+// FindByName returns the only alert with given display name
+func (a AlertAPI) FindByName(displayName string) (*api.Alert, error) {
+	alerts, err := a.List()
+	if err != nil {
+		return nil, err
+	}
+	var found []api.Alert
+	for _, alert := range alerts {
+		if alert.DisplayName == displayName && alert.LifecycleState != "TRASHED" {
+			found = append(found, alert)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("there is no alert with name '%s'", displayName)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("there are %d alerts with name '%s', use id instead", len(found), displayName)
+	}
+}
+
+// DataSourceAlert looks up existing SQL alert by ID or display name
+func DataSourceAlert() *schema.Resource {
+	s := common.StructToSchema(alertData{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["id"].ExactlyOneOf = []string{"id", "display_name"}
+		s["display_name"].ExactlyOneOf = []string{"id", "display_name"}
+		return s
+	})
+	return &schema.Resource{
+		Schema: s,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData,
+			m interface{}) diag.Diagnostics {
+			var data alertData
+			err := common.DataToStructPointer(d, s, &data)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			alertAPI := NewAlertAPI(ctx, m)
+			var alert *api.Alert
+			// id is also populated from the state, so the name has to be checked first
+			if data.DisplayName != "" {
+				alert, err = alertAPI.FindByName(data.DisplayName)
+			} else {
+				alert, err = alertAPI.Read(data.ID)
+			}
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			data = alertData{
+				ID:          alert.ID,
+				DisplayName: alert.DisplayName,
+				QueryID:     alert.QueryID,
+				Condition: &AlertConditionEntity{
+					Op:               alert.Condition.Op,
+					Column:           alert.Condition.Operand.Column.Name,
+					Threshold:        alertThresholdString(alert.Condition.Threshold),
+					EmptyResultState: alert.Condition.EmptyResultState,
+				},
+				CustomSubject:      alert.CustomSubject,
+				CustomBody:         alert.CustomBody,
+				NotifyOnOk:         alert.NotifyOnOk,
+				SecondsToRetrigger: alert.SecondsToRetrigger,
+				ParentPath:         alert.ParentPath,
+				OwnerUserName:      alert.OwnerUserName,
+				State:              alert.State,
+			}
+			jobs, err := alertAPI.ListSchedules(alert.ID)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			for _, job := range jobs {
+				for _, task := range job.Settings.Tasks {
+					if task.SQLTask.Alert.AlertID != alert.ID {
+						continue
+					}
+					for _, sub := range task.SQLTask.Alert.Subscriptions {
+						data.Destinations = append(data.Destinations, AlertDestination{
+							JobID:         strconv.FormatInt(job.JobID, 10),
+							DestinationID: sub.DestinationID,
+							UserName:      sub.UserName,
+						})
+					}
+				}
+			}
+			err = common.StructToData(data, s, d)
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			d.SetId(alert.ID)
+			return nil
+		},
+	}
+}
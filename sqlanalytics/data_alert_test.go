@@ -0,0 +1,154 @@
+package sqlanalytics
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/databrickslabs/terraform-provider-databricks/sqlanalytics/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAlertJobListFixture = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/jobs/list?expand_tasks=true&limit=25&offset=0",
+	Response: alertJobList{
+		Jobs: []alertJob{
+			{
+				JobID: 123,
+				Settings: alertJobSettings{
+					Name: "Some other job",
+				},
+			},
+			{
+				JobID:    789,
+				Settings: testAlertJobSettings,
+			},
+		},
+	},
+}
+
+func TestDataSourceAlert_ByID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/alerts/abc",
+				Response: testAlert,
+			},
+			testAlertJobListFixture,
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceAlert(),
+		ID:          "_",
+		HCL:         `id = "abc"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "Too many errors", d.Get("display_name"))
+	assert.Equal(t, "q1", d.Get("query_id"))
+	assert.Equal(t, "GREATER_THAN", d.Get("condition.0.op"))
+	assert.Equal(t, "errors", d.Get("condition.0.column"))
+	assert.Equal(t, "100", d.Get("condition.0.threshold"))
+	assert.Equal(t, "me@example.com", d.Get("owner_user_name"))
+	assert.Equal(t, 2, d.Get("destinations.#"))
+	assert.Equal(t, "789", d.Get("destinations.0.job_id"))
+	assert.Equal(t, "d1", d.Get("destinations.0.destination_id"))
+	assert.Equal(t, "ops@example.com", d.Get("destinations.1.user_name"))
+}
+
+func TestDataSourceAlert_ByName(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/alerts",
+				Response: api.AlertList{
+					Results: []api.Alert{
+						{
+							ID:             "old",
+							DisplayName:    "Too many errors",
+							LifecycleState: "TRASHED",
+						},
+						{
+							ID:          "xyz",
+							DisplayName: "Another alert",
+						},
+					},
+					NextPageToken: "next",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/alerts?page_token=next",
+				Response: api.AlertList{
+					Results: []api.Alert{testAlert},
+				},
+			},
+			testAlertJobListFixture,
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceAlert(),
+		ID:          "_",
+		HCL:         `display_name = "Too many errors"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "abc", d.Get("id"))
+	assert.Equal(t, "OK", d.Get("state"))
+	assert.Equal(t, 2, d.Get("destinations.#"))
+}
+
+func TestDataSourceAlert_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/alerts",
+				Response: api.AlertList{
+					Results: []api.Alert{
+						{
+							ID:          "xyz",
+							DisplayName: "Another alert",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceAlert(),
+		ID:          "_",
+		HCL:         `display_name = "Too many errors"`,
+	}.ExpectError(t, "there is no alert with name 'Too many errors'")
+}
+
+func TestDataSourceAlert_Ambiguous(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/alerts",
+				Response: api.AlertList{
+					Results: []api.Alert{
+						{
+							ID:          "abc",
+							DisplayName: "Too many errors",
+						},
+						{
+							ID:          "xyz",
+							DisplayName: "Too many errors",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceAlert(),
+		ID:          "_",
+		HCL:         `display_name = "Too many errors"`,
+	}.ExpectError(t, "there are 2 alerts with name 'Too many errors', use id instead")
+}
@@ -0,0 +1,259 @@
+package sqlanalytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultStatementTimeout is the time to wait for results of a statement on SQL warehouse
+const defaultStatementTimeout = 10 * time.Minute
+
+// warehouseEventsQuery reads scaling and start/stop events of SQL warehouse from system tables
+const warehouseEventsQuery = `SELECT event_time, event_type, cluster_count
+FROM system.compute.warehouse_events
+WHERE warehouse_id = :warehouse_id
+  AND event_time >= current_timestamp() - make_interval(0, 0, 0, 0, :lookback_hours)
+ORDER BY event_time`
+
+// StatementParameter is a named parameter marker of SQL statement
+type StatementParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Type  string `json:"type,omitempty"`
+}
+
+// StatementRequest executes SQL statement on SQL warehouse
+type StatementRequest struct {
+	WarehouseID string               `json:"warehouse_id"`
+	Statement   string               `json:"statement"`
+	Parameters  []StatementParameter `json:"parameters,omitempty"`
+	WaitTimeout string               `json:"wait_timeout,omitempty"`
+	Disposition string               `json:"disposition,omitempty"`
+	Format      string               `json:"format,omitempty"`
+}
+
+// StatementError describes the failure of SQL statement
+type StatementError struct {
+	ErrorCode string `json:"error_code,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// StatementStatus is the execution state of SQL statement
+type StatementStatus struct {
+	State string          `json:"state"`
+	Error *StatementError `json:"error,omitempty"`
+}
+
+// StatementResult holds rows of statement result as arrays of strings
+type StatementResult struct {
+	DataArray [][]*string `json:"data_array,omitempty"`
+}
+
+// StatementResponse is the status and the inline result of SQL statement
+type StatementResponse struct {
+	StatementID string           `json:"statement_id"`
+	Status      StatementStatus  `json:"status"`
+	Result      *StatementResult `json:"result,omitempty"`
+}
+
+// NewStatementExecutionAPI creates StatementExecutionAPI instance from provider meta
+func NewStatementExecutionAPI(ctx context.Context, m interface{}) StatementExecutionAPI {
+	return StatementExecutionAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// StatementExecutionAPI exposes the SQL Statement Execution API
+type StatementExecutionAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Execute runs SQL statement and waits for its inline result
+func (a StatementExecutionAPI) Execute(req StatementRequest, timeout time.Duration) (*StatementResult, error) {
+	req.WaitTimeout = "30s"
+	req.Disposition = "INLINE"
+	req.Format = "JSON_ARRAY"
+	var sr StatementResponse
+	err := a.client.Post(a.context, "/sql/statements", req, &sr)
+	if err != nil {
+		return nil, err
+	}
+	err = resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+		switch sr.Status.State {
+		case "SUCCEEDED":
+			return nil
+		case "FAILED", "CANCELED", "CLOSED":
+			message := sr.Status.State
+			if sr.Status.Error != nil {
+				message = sr.Status.Error.Message
+			}
+			return resource.NonRetryableError(fmt.Errorf("statement %s failed: %s",
+				sr.StatementID, message))
+		}
+		err := a.client.Get(a.context, "/sql/statements/"+sr.StatementID, nil, &sr)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if sr.Status.State == "SUCCEEDED" {
+			return nil
+		}
+		log.Printf("[DEBUG] Statement %s is %s", sr.StatementID, sr.Status.State)
+		return resource.RetryableError(fmt.Errorf("statement %s is %s",
+			sr.StatementID, sr.Status.State))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sr.Result == nil {
+		return &StatementResult{}, nil
+	}
+	return sr.Result, nil
+}
+
+// WarehouseEvent is a scaling or start/stop event of SQL warehouse
+type WarehouseEvent struct {
+	EventTime    string `json:"event_time"`
+	EventType    string `json:"event_type"`
+	ClusterCount int    `json:"cluster_count"`
+}
+
+func stringOrEmpty(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// ListWarehouseEvents returns events of SQL warehouse within lookback window, that are
+// recorded in system tables and read through the given statement warehouse
+func ListWarehouseEvents(ctx context.Context, m interface{}, warehouseID, statementWarehouseID string,
+	lookbackHours int) (events []WarehouseEvent, err error) {
+	result, err := NewStatementExecutionAPI(ctx, m).Execute(StatementRequest{
+		WarehouseID: statementWarehouseID,
+		Statement:   warehouseEventsQuery,
+		Parameters: []StatementParameter{
+			{Name: "warehouse_id", Value: warehouseID, Type: "STRING"},
+			{Name: "lookback_hours", Value: strconv.Itoa(lookbackHours), Type: "INT"},
+		},
+	}, defaultStatementTimeout)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range result.DataArray {
+		if len(row) < 3 {
+			return nil, fmt.Errorf("unexpected row of warehouse events: %d columns", len(row))
+		}
+		event := WarehouseEvent{
+			EventTime: stringOrEmpty(row[0]),
+			EventType: stringOrEmpty(row[1]),
+		}
+		if row[2] != nil {
+			if event.ClusterCount, err = strconv.Atoi(*row[2]); err != nil {
+				return nil, fmt.Errorf("cannot parse cluster count: %w", err)
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// DataSourceSQLWarehouseEvents lists scaling and start/stop events of SQL warehouse
+func DataSourceSQLWarehouseEvents() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"warehouse_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"statement_warehouse_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lookback_hours": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          24,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 24*365)),
+			},
+			"event_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"STARTING", "RUNNING",
+						"STOPPING", "STOPPED", "SCALED_UP", "SCALED_DOWN"}, false),
+				},
+			},
+			"max_cluster_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			warehouseID := d.Get("warehouse_id").(string)
+			statementWarehouseID := d.Get("statement_warehouse_id").(string)
+			if statementWarehouseID == "" {
+				statementWarehouseID = warehouseID
+			}
+			events, err := ListWarehouseEvents(ctx, m, warehouseID, statementWarehouseID,
+				d.Get("lookback_hours").(int))
+			if err != nil {
+				return common.DiagnosticsFromErr(err)
+			}
+			eventTypes := map[string]bool{}
+			for _, v := range d.Get("event_types").(*schema.Set).List() {
+				eventTypes[v.(string)] = true
+			}
+			maxClusterCount := 0
+			list := []map[string]interface{}{}
+			for _, event := range events {
+				if event.ClusterCount > maxClusterCount {
+					maxClusterCount = event.ClusterCount
+				}
+				if len(eventTypes) > 0 && !eventTypes[event.EventType] {
+					continue
+				}
+				list = append(list, map[string]interface{}{
+					"event_time":    event.EventTime,
+					"event_type":    event.EventType,
+					"cluster_count": event.ClusterCount,
+				})
+			}
+			// nolint
+			d.Set("max_cluster_count", maxClusterCount)
+			// nolint
+			d.Set("events", list)
+			d.SetId(warehouseID)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package sqlanalytics
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestDataSourceSQLWarehouseEvents(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				ExpectedRequest: StatementRequest{
+					WarehouseID: "monitoring",
+					Statement:   warehouseEventsQuery,
+					Parameters: []StatementParameter{
+						{Name: "warehouse_id", Value: "abc", Type: "STRING"},
+						{Name: "lookback_hours", Value: "48", Type: "INT"},
+					},
+					WaitTimeout: "30s",
+					Disposition: "INLINE",
+					Format:      "JSON_ARRAY",
+				},
+				Response: StatementResponse{
+					StatementID: "s1",
+					Status: StatementStatus{
+						State: "PENDING",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/statements/s1",
+				Response: StatementResponse{
+					StatementID: "s1",
+					Status: StatementStatus{
+						State: "SUCCEEDED",
+					},
+					Result: &StatementResult{
+						DataArray: [][]*string{
+							{strPtr("2024-01-01T10:00:00Z"), strPtr("STARTING"), strPtr("0")},
+							{strPtr("2024-01-01T10:01:00Z"), strPtr("RUNNING"), strPtr("1")},
+							{strPtr("2024-01-01T11:00:00Z"), strPtr("SCALED_UP"), strPtr("3")},
+							{strPtr("2024-01-01T12:00:00Z"), strPtr("SCALED_DOWN"), strPtr("1")},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceSQLWarehouseEvents(),
+		ID:          ".",
+		HCL: `warehouse_id = "abc"
+		statement_warehouse_id = "monitoring"
+		lookback_hours = 48
+		event_types = ["SCALED_UP", "SCALED_DOWN"]`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 3, d.Get("max_cluster_count"))
+	assert.Equal(t, 2, d.Get("events.#"))
+	assert.Equal(t, "SCALED_UP", d.Get("events.0.event_type"))
+	assert.Equal(t, 3, d.Get("events.0.cluster_count"))
+}
+
+func TestDataSourceSQLWarehouseEvents_Failed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/statements",
+				Response: StatementResponse{
+					StatementID: "s1",
+					Status: StatementStatus{
+						State: "FAILED",
+						Error: &StatementError{
+							ErrorCode: "PERMISSION_DENIED",
+							Message:   "User does not have USE SCHEMA on Schema 'system.compute'",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceSQLWarehouseEvents(),
+		ID:          ".",
+		HCL:         `warehouse_id = "abc"`,
+	}.ExpectError(t, "statement s1 failed: User does not have USE SCHEMA on Schema 'system.compute'")
+}
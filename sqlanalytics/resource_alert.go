@@ -0,0 +1,485 @@
+package sqlanalytics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/sqlanalytics/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// alertUpdateMask lists all fields, that are sent on every update of an alert
+const alertUpdateMask = "display_name,query_id,condition,custom_subject,custom_body,notify_on_ok,seconds_to_retrigger"
+
+// AlertEntity defines the parameters that can be set in the resource.
+type AlertEntity struct {
+	DisplayName        string                `json:"display_name"`
+	QueryID            string                `json:"query_id"`
+	Condition          *AlertConditionEntity `json:"condition"`
+	CustomSubject      string                `json:"custom_subject,omitempty"`
+	CustomBody         string                `json:"custom_body,omitempty"`
+	NotifyOnOk         bool                  `json:"notify_on_ok,omitempty"`
+	SecondsToRetrigger int                   `json:"seconds_to_retrigger,omitempty"`
+	ParentPath         string                `json:"parent_path,omitempty" tf:"force_new"`
+	Schedule           *AlertSchedule        `json:"schedule,omitempty"`
+	OwnerUserName      string                `json:"owner_user_name,omitempty" tf:"computed"`
+	State              string                `json:"state,omitempty" tf:"computed"`
+	JobID              string                `json:"job_id,omitempty" tf:"computed"`
+}
+
+// AlertConditionEntity flattens operand and threshold of an alert condition.
+type AlertConditionEntity struct {
+	Op               string `json:"op"`
+	Column           string `json:"column"`
+	Threshold        string `json:"threshold,omitempty"`
+	EmptyResultState string `json:"empty_result_state,omitempty" tf:"computed"`
+}
+
+// AlertSchedule is evaluated by a job with SQL alert task, that is managed along with the alert.
+type AlertSchedule struct {
+	QuartzCronExpression string              `json:"quartz_cron_expression"`
+	TimezoneID           string              `json:"timezone_id"`
+	PauseStatus          string              `json:"pause_status,omitempty" tf:"computed"`
+	WarehouseID          string              `json:"warehouse_id"`
+	Subscriptions        []AlertSubscription `json:"subscriptions,omitempty" tf:"alias:subscription"`
+}
+
+// AlertSubscription is either a notification destination or a workspace user.
+type AlertSubscription struct {
+	DestinationID string `json:"destination_id,omitempty"`
+	UserName      string `json:"user_name,omitempty"`
+}
+
+type alertJobSettings struct {
+	Name     string          `json:"name"`
+	Format   string          `json:"format"`
+	Tasks    []alertJobTask  `json:"tasks"`
+	Schedule *alertCronEntry `json:"schedule,omitempty"`
+}
+
+type alertCronEntry struct {
+	QuartzCronExpression string `json:"quartz_cron_expression"`
+	TimezoneID           string `json:"timezone_id"`
+	PauseStatus          string `json:"pause_status,omitempty"`
+}
+
+type alertJobTask struct {
+	TaskKey string       `json:"task_key"`
+	SQLTask alertSQLTask `json:"sql_task"`
+}
+
+type alertSQLTask struct {
+	WarehouseID string        `json:"warehouse_id"`
+	Alert       alertTaskSpec `json:"alert"`
+}
+
+type alertTaskSpec struct {
+	AlertID       string              `json:"alert_id"`
+	Subscriptions []AlertSubscription `json:"subscriptions,omitempty"`
+}
+
+type alertJob struct {
+	JobID    int64            `json:"job_id,omitempty"`
+	Settings alertJobSettings `json:"settings,omitempty"`
+}
+
+type alertJobReset struct {
+	JobID       int64            `json:"job_id"`
+	NewSettings alertJobSettings `json:"new_settings"`
+}
+
+func alertThresholdValue(threshold string) *api.AlertThreshold {
+	if threshold == "" {
+		return nil
+	}
+	var v api.AlertValue
+	if f, err := strconv.ParseFloat(threshold, 64); err == nil {
+		v.DoubleValue = &f
+	} else if b, err := strconv.ParseBool(threshold); err == nil {
+		v.BoolValue = &b
+	} else {
+		v.StringValue = &threshold
+	}
+	return &api.AlertThreshold{Value: v}
+}
+
+func alertThresholdString(t *api.AlertThreshold) string {
+	if t == nil {
+		return ""
+	}
+	switch {
+	case t.Value.DoubleValue != nil:
+		return strconv.FormatFloat(*t.Value.DoubleValue, 'f', -1, 64)
+	case t.Value.BoolValue != nil:
+		return strconv.FormatBool(*t.Value.BoolValue)
+	case t.Value.StringValue != nil:
+		return *t.Value.StringValue
+	}
+	return ""
+}
+
+// suppressNumericThresholdDiff ignores different spelling of the same number, like `1` and `1.0`
+func suppressNumericThresholdDiff(k, old, new string, d *schema.ResourceData) bool {
+	of, err := strconv.ParseFloat(old, 64)
+	if err != nil {
+		return false
+	}
+	nf, err := strconv.ParseFloat(new, 64)
+	if err != nil {
+		return false
+	}
+	return of == nf
+}
+
+func (a *AlertEntity) toAPIObject(s map[string]*schema.Schema, data *schema.ResourceData) (*api.Alert, error) {
+	err := common.DataToStructPointer(data, s, a)
+	if err != nil {
+		return nil, err
+	}
+	if a.Schedule != nil {
+		for _, sub := range a.Schedule.Subscriptions {
+			if (sub.DestinationID == "") == (sub.UserName == "") {
+				return nil, fmt.Errorf("subscription must have exactly one of destination_id or user_name")
+			}
+		}
+	}
+	var condition api.AlertCondition
+	if a.Condition != nil {
+		condition = api.AlertCondition{
+			Op: a.Condition.Op,
+			Operand: api.AlertOperand{
+				Column: api.AlertOperandColumn{
+					Name: a.Condition.Column,
+				},
+			},
+			Threshold:        alertThresholdValue(a.Condition.Threshold),
+			EmptyResultState: a.Condition.EmptyResultState,
+		}
+	}
+	return &api.Alert{
+		DisplayName:        a.DisplayName,
+		QueryID:            a.QueryID,
+		Condition:          condition,
+		CustomSubject:      a.CustomSubject,
+		CustomBody:         a.CustomBody,
+		NotifyOnOk:         a.NotifyOnOk,
+		SecondsToRetrigger: a.SecondsToRetrigger,
+		ParentPath:         a.ParentPath,
+	}, nil
+}
+
+func (a *AlertEntity) fromAPIObject(aa *api.Alert, s map[string]*schema.Schema, data *schema.ResourceData) error {
+	a.DisplayName = aa.DisplayName
+	a.QueryID = aa.QueryID
+	a.Condition = &AlertConditionEntity{
+		Op:               aa.Condition.Op,
+		Column:           aa.Condition.Operand.Column.Name,
+		Threshold:        alertThresholdString(aa.Condition.Threshold),
+		EmptyResultState: aa.Condition.EmptyResultState,
+	}
+	a.CustomSubject = aa.CustomSubject
+	a.CustomBody = aa.CustomBody
+	a.NotifyOnOk = aa.NotifyOnOk
+	a.SecondsToRetrigger = aa.SecondsToRetrigger
+	a.ParentPath = aa.ParentPath
+	a.OwnerUserName = aa.OwnerUserName
+	a.State = aa.State
+	return common.StructToData(*a, s, data)
+}
+
+// jobSettings returns settings of a job, that evaluates the alert on schedule
+func (a *AlertEntity) jobSettings(alertID string) alertJobSettings {
+	return alertJobSettings{
+		Name:   fmt.Sprintf("Alert: %s", a.DisplayName),
+		Format: "MULTI_TASK",
+		Tasks: []alertJobTask{
+			{
+				TaskKey: "alert",
+				SQLTask: alertSQLTask{
+					WarehouseID: a.Schedule.WarehouseID,
+					Alert: alertTaskSpec{
+						AlertID:       alertID,
+						Subscriptions: a.Schedule.Subscriptions,
+					},
+				},
+			},
+		},
+		Schedule: &alertCronEntry{
+			QuartzCronExpression: a.Schedule.QuartzCronExpression,
+			TimezoneID:           a.Schedule.TimezoneID,
+			PauseStatus:          a.Schedule.PauseStatus,
+		},
+	}
+}
+
+// fromJob reads schedule of the alert back from the job
+func (a *AlertEntity) fromJob(job alertJob) {
+	if job.Settings.Schedule == nil || len(job.Settings.Tasks) == 0 {
+		a.Schedule = nil
+		return
+	}
+	task := job.Settings.Tasks[0].SQLTask
+	a.Schedule = &AlertSchedule{
+		QuartzCronExpression: job.Settings.Schedule.QuartzCronExpression,
+		TimezoneID:           job.Settings.Schedule.TimezoneID,
+		PauseStatus:          job.Settings.Schedule.PauseStatus,
+		WarehouseID:          task.WarehouseID,
+		Subscriptions:        task.Alert.Subscriptions,
+	}
+}
+
+// NewAlertAPI ...
+func NewAlertAPI(ctx context.Context, m interface{}) AlertAPI {
+	return AlertAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// AlertAPI ...
+type AlertAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Create ...
+func (a AlertAPI) Create(aa *api.Alert) error {
+	return a.client.Post(a.context, "/sql/alerts", api.AlertCreate{Alert: aa}, aa)
+}
+
+// Read ...
+func (a AlertAPI) Read(alertID string) (*api.Alert, error) {
+	var aa api.Alert
+	err := a.client.Get(a.context, fmt.Sprintf("/sql/alerts/%s", alertID), nil, &aa)
+	if err != nil {
+		return nil, err
+	}
+	return &aa, nil
+}
+
+// List returns all alerts, that are visible to the caller
+func (a AlertAPI) List() (alerts []api.Alert, err error) {
+	var request interface{}
+	for {
+		var page api.AlertList
+		err = a.client.Get(a.context, "/sql/alerts", request, &page)
+		if err != nil {
+			return
+		}
+		alerts = append(alerts, page.Results...)
+		if page.NextPageToken == "" {
+			return
+		}
+		request = map[string]string{
+			"page_token": page.NextPageToken,
+		}
+	}
+}
+
+// Update ...
+func (a AlertAPI) Update(alertID string, aa *api.Alert) error {
+	return a.client.Patch(a.context, fmt.Sprintf("/sql/alerts/%s", alertID), api.AlertUpdate{
+		UpdateMask: alertUpdateMask,
+		Alert:      aa,
+	})
+}
+
+// Delete moves alert to trash
+func (a AlertAPI) Delete(alertID string) error {
+	return a.client.Delete(a.context, fmt.Sprintf("/sql/alerts/%s", alertID), nil)
+}
+
+// CreateSchedule creates a job, that evaluates the alert on schedule
+func (a AlertAPI) CreateSchedule(settings alertJobSettings) (string, error) {
+	var job alertJob
+	err := a.client.Post(a.context, "/jobs/create", settings, &job)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(job.JobID, 10), nil
+}
+
+// ReadSchedule returns the job, that evaluates the alert on schedule
+func (a AlertAPI) ReadSchedule(jobID string) (job alertJob, err error) {
+	id, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		return
+	}
+	err = a.client.Get(a.context, "/jobs/get", map[string]int64{
+		"job_id": id,
+	}, &job)
+	if isMissingJob(err, jobID) {
+		err = common.APIError{
+			ErrorCode:  "RESOURCE_DOES_NOT_EXIST",
+			Message:    fmt.Sprintf("Job %s does not exist.", jobID),
+			StatusCode: 404,
+		}
+	}
+	return
+}
+
+// UpdateSchedule overwrites settings of the job, that evaluates the alert on schedule
+func (a AlertAPI) UpdateSchedule(jobID string, settings alertJobSettings) error {
+	id, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		return err
+	}
+	return a.client.Post(a.context, "/jobs/reset", alertJobReset{
+		JobID:       id,
+		NewSettings: settings,
+	}, nil)
+}
+
+// DeleteSchedule removes the job, that evaluates the alert on schedule
+func (a AlertAPI) DeleteSchedule(jobID string) error {
+	id, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		return err
+	}
+	err = a.client.Post(a.context, "/jobs/delete", map[string]int64{
+		"job_id": id,
+	}, nil)
+	if isMissingJob(err, jobID) {
+		return nil
+	}
+	return err
+}
+
+// isMissingJob also detects jobs API responding with 400 for removed jobs
+func isMissingJob(err error, jobID string) bool {
+	ae, ok := err.(common.APIError)
+	if !ok {
+		return false
+	}
+	return ae.IsMissing() || strings.Contains(ae.Message,
+		fmt.Sprintf("Job %s does not exist.", jobID))
+}
+
+// ResourceAlert manages alerts through GA SQL Alerts API
+func ResourceAlert() *schema.Resource {
+	s := common.StructToSchema(
+		AlertEntity{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			condition := m["condition"].Elem.(*schema.Resource)
+			condition.Schema["op"].ValidateFunc = validation.StringInSlice([]string{
+				"GREATER_THAN",
+				"GREATER_THAN_OR_EQUAL",
+				"LESS_THAN",
+				"LESS_THAN_OR_EQUAL",
+				"EQUAL",
+				"NOT_EQUAL",
+				"IS_NULL",
+			}, false)
+			condition.Schema["empty_result_state"].ValidateFunc = validation.StringInSlice([]string{
+				"UNKNOWN",
+				"OK",
+				"TRIGGERED",
+			}, false)
+			condition.Schema["threshold"].DiffSuppressFunc = suppressNumericThresholdDiff
+
+			schedule := m["schedule"].Elem.(*schema.Resource)
+			schedule.Schema["pause_status"].ValidateFunc = validation.StringInSlice([]string{
+				"PAUSED",
+				"UNPAUSED",
+			}, false)
+			m["seconds_to_retrigger"].ValidateFunc = validation.IntAtLeast(0)
+			return m
+		})
+
+	return common.Resource{
+		Create: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
+			var a AlertEntity
+			aa, err := a.toAPIObject(s, data)
+			if err != nil {
+				return err
+			}
+			alertsAPI := NewAlertAPI(ctx, c)
+			err = alertsAPI.Create(aa)
+			if err != nil {
+				return err
+			}
+			data.SetId(aa.ID)
+			if a.Schedule == nil {
+				return nil
+			}
+			jobID, err := alertsAPI.CreateSchedule(a.jobSettings(aa.ID))
+			if err != nil {
+				return fmt.Errorf("cannot schedule alert %s: %w", aa.ID, err)
+			}
+			return data.Set("job_id", jobID)
+		},
+		Read: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
+			alertsAPI := NewAlertAPI(ctx, c)
+			aa, err := alertsAPI.Read(data.Id())
+			if err != nil {
+				return err
+			}
+			a := AlertEntity{
+				JobID: data.Get("job_id").(string),
+			}
+			if a.JobID != "" {
+				job, err := alertsAPI.ReadSchedule(a.JobID)
+				if ae, ok := err.(common.APIError); ok && ae.IsMissing() {
+					// job was removed outside of terraform, so it has to be re-created
+					a.JobID = ""
+				} else if err != nil {
+					return err
+				}
+				a.fromJob(job)
+			}
+			err = a.fromAPIObject(aa, s, data)
+			if err != nil {
+				return err
+			}
+			// empty values are skipped by StructToData, so schedule removed outside has to be reset
+			if a.Schedule == nil {
+				err = data.Set("schedule", nil)
+				if err != nil {
+					return err
+				}
+			}
+			return data.Set("job_id", a.JobID)
+		},
+		Update: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
+			var a AlertEntity
+			aa, err := a.toAPIObject(s, data)
+			if err != nil {
+				return err
+			}
+			alertsAPI := NewAlertAPI(ctx, c)
+			err = alertsAPI.Update(data.Id(), aa)
+			if err != nil {
+				return err
+			}
+			switch {
+			case a.Schedule != nil && a.JobID == "":
+				jobID, err := alertsAPI.CreateSchedule(a.jobSettings(data.Id()))
+				if err != nil {
+					return fmt.Errorf("cannot schedule alert %s: %w", data.Id(), err)
+				}
+				return data.Set("job_id", jobID)
+			case a.Schedule != nil:
+				return alertsAPI.UpdateSchedule(a.JobID, a.jobSettings(data.Id()))
+			case a.JobID != "":
+				err = alertsAPI.DeleteSchedule(a.JobID)
+				if err != nil {
+					return err
+				}
+				return data.Set("job_id", "")
+			}
+			return nil
+		},
+		Delete: func(ctx context.Context, data *schema.ResourceData, c *common.DatabricksClient) error {
+			alertsAPI := NewAlertAPI(ctx, c)
+			if jobID := data.Get("job_id").(string); jobID != "" {
+				err := alertsAPI.DeleteSchedule(jobID)
+				if err != nil {
+					return err
+				}
+			}
+			return alertsAPI.Delete(data.Id())
+		},
+		Schema: s,
+	}.ToResource()
+}
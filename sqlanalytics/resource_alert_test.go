@@ -0,0 +1,352 @@
+package sqlanalytics
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/databrickslabs/terraform-provider-databricks/sqlanalytics/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
+
+var testAlert = api.Alert{
+	ID:          "abc",
+	DisplayName: "Too many errors",
+	QueryID:     "q1",
+	Condition: api.AlertCondition{
+		Op: "GREATER_THAN",
+		Operand: api.AlertOperand{
+			Column: api.AlertOperandColumn{
+				Name: "errors",
+			},
+		},
+		Threshold: &api.AlertThreshold{
+			Value: api.AlertValue{
+				DoubleValue: float64Ptr(100),
+			},
+		},
+		EmptyResultState: "OK",
+	},
+	SecondsToRetrigger: 600,
+	OwnerUserName:      "me@example.com",
+	State:              "OK",
+}
+
+var testAlertJobSettings = alertJobSettings{
+	Name:   "Alert: Too many errors",
+	Format: "MULTI_TASK",
+	Tasks: []alertJobTask{
+		{
+			TaskKey: "alert",
+			SQLTask: alertSQLTask{
+				WarehouseID: "w1",
+				Alert: alertTaskSpec{
+					AlertID: "abc",
+					Subscriptions: []AlertSubscription{
+						{DestinationID: "d1"},
+						{UserName: "ops@example.com"},
+					},
+				},
+			},
+		},
+	},
+	Schedule: &alertCronEntry{
+		QuartzCronExpression: "0 0/15 * * * ?",
+		TimezoneID:           "UTC",
+	},
+}
+
+const testAlertHCL = `
+display_name = "Too many errors"
+query_id = "q1"
+seconds_to_retrigger = 600
+condition {
+	op = "GREATER_THAN"
+	column = "errors"
+	threshold = "100.0"
+	empty_result_state = "OK"
+}`
+
+const testAlertScheduleHCL = `
+schedule {
+	quartz_cron_expression = "0 0/15 * * * ?"
+	timezone_id = "UTC"
+	warehouse_id = "w1"
+	subscription {
+		destination_id = "d1"
+	}
+	subscription {
+		user_name = "ops@example.com"
+	}
+}`
+
+func TestAlertCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/sql/alerts",
+				ExpectedRequest: api.AlertCreate{
+					Alert: &api.Alert{
+						DisplayName: "Too many errors",
+						QueryID:     "q1",
+						Condition: api.AlertCondition{
+							Op: "GREATER_THAN",
+							Operand: api.AlertOperand{
+								Column: api.AlertOperandColumn{
+									Name: "errors",
+								},
+							},
+							Threshold: &api.AlertThreshold{
+								Value: api.AlertValue{
+									DoubleValue: float64Ptr(100),
+								},
+							},
+							EmptyResultState: "OK",
+						},
+						SecondsToRetrigger: 600,
+					},
+				},
+				Response: testAlert,
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/jobs/create",
+				ExpectedRequest: testAlertJobSettings,
+				Response: alertJob{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/alerts/abc",
+				Response: testAlert,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: alertJob{
+					JobID:    789,
+					Settings: testAlertJobSettings,
+				},
+			},
+		},
+		Resource: ResourceAlert(),
+		Create:   true,
+		HCL:      testAlertHCL + testAlertScheduleHCL,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "789", d.Get("job_id"))
+	assert.Equal(t, "100", d.Get("condition.0.threshold"))
+	assert.Equal(t, "me@example.com", d.Get("owner_user_name"))
+	assert.Equal(t, "w1", d.Get("schedule.0.warehouse_id"))
+	assert.Equal(t, "ops@example.com", d.Get("schedule.0.subscription.1.user_name"))
+}
+
+func TestAlertCreate_InvalidSubscription(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceAlert(),
+		Create:   true,
+		HCL: testAlertHCL + `
+		schedule {
+			quartz_cron_expression = "0 0/15 * * * ?"
+			timezone_id = "UTC"
+			warehouse_id = "w1"
+			subscription {
+				destination_id = "d1"
+				user_name = "ops@example.com"
+			}
+		}`,
+	}.ExpectError(t, "subscription must have exactly one of destination_id or user_name")
+}
+
+func TestAlertRead_ScheduleRemovedOutside(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/alerts/abc",
+				Response: testAlert,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Status:   404,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Job 789 does not exist.",
+				},
+			},
+		},
+		Resource: ResourceAlert(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+		State: map[string]interface{}{
+			"job_id": "789",
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "", d.Get("job_id"))
+	assert.Equal(t, 0, d.Get("schedule.#"))
+	assert.Equal(t, "Too many errors", d.Get("display_name"))
+	assert.Equal(t, "errors", d.Get("condition.0.column"))
+}
+
+func TestAlertUpdate_RemoveSchedule(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/sql/alerts/abc",
+				ExpectedRequest: api.AlertUpdate{
+					UpdateMask: alertUpdateMask,
+					Alert: &api.Alert{
+						DisplayName: "Too many errors",
+						QueryID:     "q1",
+						Condition: api.AlertCondition{
+							Op: "GREATER_THAN",
+							Operand: api.AlertOperand{
+								Column: api.AlertOperandColumn{
+									Name: "errors",
+								},
+							},
+							Threshold: &api.AlertThreshold{
+								Value: api.AlertValue{
+									DoubleValue: float64Ptr(100),
+								},
+							},
+							EmptyResultState: "OK",
+						},
+						SecondsToRetrigger: 600,
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/delete",
+				ExpectedRequest: map[string]int64{
+					"job_id": 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/alerts/abc",
+				Response: testAlert,
+			},
+		},
+		Resource: ResourceAlert(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"display_name":                        "Too many errors",
+			"query_id":                            "q1",
+			"job_id":                              "789",
+			"condition.#":                         "1",
+			"condition.0.op":                      "GREATER_THAN",
+			"condition.0.column":                  "errors",
+			"condition.0.threshold":               "50",
+			"schedule.#":                          "1",
+			"schedule.0.quartz_cron_expression":   "0 0/15 * * * ?",
+			"schedule.0.timezone_id":              "UTC",
+			"schedule.0.warehouse_id":             "w1",
+			"schedule.0.subscription.#":           "1",
+			"schedule.0.subscription.0.user_name": "ops@example.com",
+		},
+		HCL: testAlertHCL,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "", d.Get("job_id"))
+	assert.Equal(t, 0, d.Get("schedule.#"))
+}
+
+func TestAlertUpdate_AddSchedule(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/sql/alerts/abc",
+			},
+			{
+				Method:          "POST",
+				Resource:        "/api/2.0/jobs/create",
+				ExpectedRequest: testAlertJobSettings,
+				Response: alertJob{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/sql/alerts/abc",
+				Response: testAlert,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: alertJob{
+					JobID:    789,
+					Settings: testAlertJobSettings,
+				},
+			},
+		},
+		Resource: ResourceAlert(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"display_name":       "Too many errors",
+			"query_id":           "q1",
+			"condition.#":        "1",
+			"condition.0.op":     "GREATER_THAN",
+			"condition.0.column": "errors",
+		},
+		HCL: testAlertHCL + testAlertScheduleHCL,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+}
+
+func TestAlertDelete(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/delete",
+				ExpectedRequest: map[string]int64{
+					"job_id": 789,
+				},
+				Status: 400,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Job 789 does not exist.",
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/sql/alerts/abc",
+			},
+		},
+		Resource: ResourceAlert(),
+		Delete:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"job_id": "789",
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+}
+
+func TestAlertThresholdRoundTrip(t *testing.T) {
+	for _, v := range []string{"", "42.5", "true", "FAILED"} {
+		assert.Equal(t, v, alertThresholdString(alertThresholdValue(v)))
+	}
+	assert.True(t, suppressNumericThresholdDiff("", "1", "1.0", nil))
+	assert.False(t, suppressNumericThresholdDiff("", "1", "a", nil))
+}
+
+func TestResourceAlertCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceAlert())
+}
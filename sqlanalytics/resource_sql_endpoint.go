@@ -13,6 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// DefaultProvisionTimeout is the amount of time terraform will wait for SQL endpoint to start
+const DefaultProvisionTimeout = 20 * time.Minute
+
 // ClusterSizes for SQL endpoints
 var (
 	ClusterSizes   = []string{"2X-Small", "X-Small", "Small", "Medium", "Large", "X-Large", "2X-Large", "3X-Large", "4X-Large"}
@@ -228,5 +231,8 @@ func ResourceSQLEndpoint() *schema.Resource {
 			return NewSQLEndpointsAPI(ctx, c).Delete(d.Id())
 		},
 		Schema: s,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
+		},
 	}.ToResource()
 }
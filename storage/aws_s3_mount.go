@@ -3,9 +3,11 @@ package storage
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/compute"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,7 +28,8 @@ func (m AWSIamMount) Config() map[string]string {
 	return make(map[string]string) // return empty map so nil map does not marshal to null
 }
 
-// ResourceAWSS3Mount ...
+// ResourceAWSS3Mount mounts S3 bucket with instance profile and re-mounts it,
+// when either bucket or instance profile changes
 func ResourceAWSS3Mount() *schema.Resource {
 	tpl := AWSIamMount{}
 	r := &schema.Resource{
@@ -50,34 +53,55 @@ func ResourceAWSS3Mount() *schema.Resource {
 			"s3_bucket_name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"instance_profile": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 		},
 		SchemaVersion: 2,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: mountTimeouts(),
 	}
 	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		if err := preprocessS3Mount(ctx, d, m); err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		return mountCreate(tpl, r)(ctx, d, m)
 	}
 	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		if err := preprocessS3Mount(ctx, d, m); err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
+		}
+		if diags := mountRead(tpl, r)(ctx, d, m); diags.HasError() {
+			return diags
+		}
+		// bucket re-mounted outside of Terraform is planned to be re-mounted back
+		source := d.Get("source").(string)
+		expected := AWSIamMount{S3BucketName: d.Get("s3_bucket_name").(string)}.Source()
+		if d.Id() != "" && strings.HasPrefix(source, "s3a://") && source != expected {
+			log.Printf("[INFO] /mnt/%s points to %s instead of expected bucket", d.Id(), source)
+			d.Set("s3_bucket_name", strings.TrimPrefix(source, "s3a://"))
+		}
+		return nil
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// re-mount may run on the cluster of new instance profile, but cluster_id
+		// is planned to stay the same, otherwise it would force new resource
+		clusterID := d.Get("cluster_id").(string)
+		if err := preprocessS3Mount(ctx, d, m); err != nil {
+			return common.DiagnosticsFromErr(err)
+		}
+		if diags := mountUpdate(tpl, r)(ctx, d, m); diags.HasError() {
+			return diags
 		}
-		return mountRead(tpl, r)(ctx, d, m)
+		return common.DiagnosticsFromErr(d.Set("cluster_id", clusterID))
 	}
 	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		if err := preprocessS3Mount(ctx, d, m); err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		return mountDelete(tpl, r)(ctx, d, m)
 	}
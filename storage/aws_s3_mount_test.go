@@ -237,13 +237,180 @@ func TestResourceAwsS3MountDelete(t *testing.T) {
 	assert.Equal(t, "", d.Get("source"))
 }
 
+func TestResourceAwsS3MountRead_RemountedOutside(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+					AwsAttributes: &compute.AwsAttributes{
+						InstanceProfileArn: "abc",
+					},
+				},
+			},
+		},
+		Resource: ResourceAWSS3Mount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "s3a://other-bucket",
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id":     "this_cluster",
+			"mount_name":     "this_mount",
+			"s3_bucket_name": testS3BucketName,
+		},
+		ID:   "this_mount",
+		Read: true,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "this_mount", d.Id())
+	assert.Equal(t, "other-bucket", d.Get("s3_bucket_name"))
+}
+
+func TestResourceAwsS3MountUpdate(t *testing.T) {
+	var commands []string
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+					AwsAttributes: &compute.AwsAttributes{
+						InstanceProfileArn: "abc",
+					},
+				},
+			},
+		},
+		Resource: ResourceAWSS3Mount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			commands = append(commands, trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"s3a://new-bucket"`)
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "s3a://new-bucket",
+			}
+		},
+		InstanceState: map[string]string{
+			"cluster_id":     "this_cluster",
+			"mount_name":     "this_mount",
+			"s3_bucket_name": testS3BucketName,
+			"source":         testS3BucketPath,
+		},
+		State: map[string]interface{}{
+			"cluster_id":     "this_cluster",
+			"mount_name":     "this_mount",
+			"s3_bucket_name": "new-bucket",
+		},
+		ID:     "this_mount",
+		Update: true,
+	}.Apply(t)
+	require.NoError(t, err)
+	require.True(t, len(commands) >= 2)
+	assert.Contains(t, commands[0], "dbutils.fs.unmount(mount_point)")
+	assert.True(t, strings.HasPrefix(commands[1], "def safe_mount"))
+	assert.Equal(t, "this_mount", d.Id())
+	assert.Equal(t, "s3a://new-bucket", d.Get("source"))
+}
+
+func TestResourceAwsS3MountUpdate_InstanceProfile(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=old_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+					AwsAttributes: &compute.AwsAttributes{
+						InstanceProfileArn: "arn:aws:iam::1234567:instance-profile/old-profile",
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=new_cluster",
+				Response: compute.ClusterInfo{
+					ClusterID: "new_cluster",
+					State:     compute.ClusterStateRunning,
+					AwsAttributes: &compute.AwsAttributes{
+						InstanceProfileArn: "arn:aws:iam::1234567:instance-profile/new-profile",
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list",
+				Response: compute.ClusterList{
+					Clusters: []compute.ClusterInfo{
+						{
+							ClusterID:   "new_cluster",
+							ClusterName: "terraform-mount-new-profile",
+							State:       compute.ClusterStateRunning,
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/spark-versions",
+				Response:     compute.SparkVersionsList{},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response:     compute.NodeTypeList{},
+			},
+		},
+		Resource: ResourceAWSS3Mount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       testS3BucketPath,
+			}
+		},
+		InstanceState: map[string]string{
+			"cluster_id":       "old_cluster",
+			"mount_name":       "this_mount",
+			"s3_bucket_name":   testS3BucketName,
+			"instance_profile": "arn:aws:iam::1234567:instance-profile/old-profile",
+			"source":           testS3BucketPath,
+		},
+		State: map[string]interface{}{
+			"mount_name":       "this_mount",
+			"s3_bucket_name":   testS3BucketName,
+			"instance_profile": "arn:aws:iam::1234567:instance-profile/new-profile",
+		},
+		ID:     "this_mount",
+		Update: true,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "old_cluster", d.Get("cluster_id"))
+	assert.Equal(t, testS3BucketPath, d.Get("source"))
+}
+
 func TestAwsAccS3Mount(t *testing.T) {
 	client := common.NewClientFromEnvironment()
 	instanceProfile := qa.GetEnvOrSkipTest(t, "TEST_EC2_INSTANCE_PROFILE")
 	ctx := context.WithValue(context.Background(), common.Current, t.Name())
 	instanceProfilesAPI := identity.NewInstanceProfilesAPI(ctx, client)
 	instanceProfilesAPI.Synchronized(instanceProfile, func() bool {
-		if err := instanceProfilesAPI.Create(instanceProfile); err != nil {
+		if err := instanceProfilesAPI.Create(identity.InstanceProfileInfo{
+			InstanceProfileArn: instanceProfile,
+		}, false); err != nil {
 			return false
 		}
 		bucket := qa.GetEnvOrSkipTest(t, "TEST_S3_BUCKET")
@@ -3,33 +3,44 @@ package storage
 import (
 	"context"
 	"encoding/base64"
+	"strings"
 
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// DataSourceDBFSFile ...
+// dbfsFileContentLimit is the maximal size of file, that is read into the state,
+// unless `limit_file_size` is turned off
+const dbfsFileContentLimit = 4e6
+
+// DataSourceDBFSFile reads content of a small DBFS file as base64
 func DataSourceDBFSFile() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			limitFileSize := d.Get("limit_file_size").(bool)
 			dbfsAPI := NewDbfsAPI(ctx, m)
-			fileInfo, err := dbfsAPI.Status(d.Get("path").(string))
+			// paths are accepted in the same form, as `dbfs_path` of databricks_dbfs_file resource
+			path := strings.TrimPrefix(d.Get("path").(string), "dbfs:")
+			fileInfo, err := dbfsAPI.Status(path)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
+			}
+			if fileInfo.IsDir {
+				return diag.Errorf("%s is a directory", fileInfo.Path)
 			}
-			// TODO: DEPRECATE/ make default
-			if limitFileSize && fileInfo.FileSize > 4e6 {
+			if limitFileSize && fileInfo.FileSize > dbfsFileContentLimit {
 				return diag.Errorf("Size of %s is too large: %d bytes",
 					fileInfo.Path, fileInfo.FileSize)
 			}
 			d.SetId(fileInfo.Path)
-			d.Set("path", fileInfo.Path)
+			// nolint
 			d.Set("file_size", fileInfo.FileSize)
 			content, err := dbfsAPI.Read(fileInfo.Path)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
+			// nolint
 			d.Set("content", base64.StdEncoding.EncodeToString(content))
 			return nil
 		},
@@ -40,20 +51,17 @@ func DataSourceDBFSFile() *schema.Resource {
 				ForceNew: true,
 			},
 			"limit_file_size": {
-				Deprecated: "Would become client property",
-				Type:       schema.TypeBool,
-				Required:   true,
-				ForceNew:   true,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"content": {
 				Type:     schema.TypeString,
 				Computed: true,
-				ForceNew: true,
 			},
 			"file_size": {
-				Deprecated: "Rename to size?...",
-				Type:       schema.TypeInt,
-				Computed:   true,
+				Type:     schema.TypeInt,
+				Computed: true,
 			},
 		},
 	}
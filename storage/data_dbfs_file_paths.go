@@ -3,12 +3,13 @@ package storage
 import (
 	"context"
 
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/workspace"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// DataSourceDBFSFilePaths ...
+// DataSourceDBFSFilePaths lists paths on DBFS under a given prefix
 func DataSourceDBFSFilePaths() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -16,7 +17,7 @@ func DataSourceDBFSFilePaths() *schema.Resource {
 			recursive := d.Get("recursive").(bool)
 			paths, err := NewDbfsAPI(ctx, m).List(path, recursive)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			d.SetId(path)
 			pathList := []map[string]interface{}{}
@@ -24,6 +25,7 @@ func DataSourceDBFSFilePaths() *schema.Resource {
 				pathData := map[string]interface{}{}
 				pathData["path"] = pathInfo.Path
 				pathData["file_size"] = pathInfo.FileSize
+				pathData["is_dir"] = pathInfo.IsDir
 				pathList = append(pathList, pathData)
 			}
 			// nolint
@@ -38,7 +40,8 @@ func DataSourceDBFSFilePaths() *schema.Resource {
 			},
 			"recursive": {
 				Type:     schema.TypeBool,
-				Required: true,
+				Optional: true,
+				Default:  false,
 				ForceNew: true,
 			},
 			"path_list": {
@@ -54,6 +57,10 @@ func DataSourceDBFSFilePaths() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
+						"is_dir": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 				Set: workspace.PathListHash,
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -54,3 +55,42 @@ func TestDataSourceFilePaths(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "/a/b/c", d.Id())
 }
+
+func TestDataSourceFilePaths_NotRecursive(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/list?path=%2Fa",
+				Response: FileList{
+					[]FileInfo{
+						{
+							Path:  "/a/b",
+							IsDir: true,
+						},
+						{
+							Path:     "/a/c",
+							FileSize: 1024,
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceDBFSFilePaths(),
+		ID:          ".",
+		HCL:         `path = "/a"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	pathList := d.Get("path_list").(*schema.Set).List()
+	require.Len(t, pathList, 2)
+	entries := map[string]map[string]interface{}{}
+	for _, v := range pathList {
+		entry := v.(map[string]interface{})
+		entries[entry["path"].(string)] = entry
+	}
+	assert.Equal(t, true, entries["/a/b"]["is_dir"])
+	assert.Equal(t, false, entries["/a/c"]["is_dir"])
+	assert.Equal(t, 1024, entries["/a/c"]["file_size"])
+}
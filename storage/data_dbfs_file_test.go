@@ -42,3 +42,46 @@ func TestDataSourceFile(t *testing.T) {
 	assert.Equal(t, "/a/b/c", d.Id())
 	assert.Equal(t, "SGVsbG8gd29ybGQK", d.Get("content"))
 }
+
+func TestDataSourceFile_DbfsPrefixTooLarge(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/get-status?path=%2Fa%2Fb%2Fc",
+				Response: FileInfo{
+					Path:     "/a/b/c",
+					FileSize: 5e6,
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceDBFSFile(),
+		ID:          ".",
+		State: map[string]interface{}{
+			"path":            "dbfs:/a/b/c",
+			"limit_file_size": true,
+		},
+	}.ExpectError(t, "Size of /a/b/c is too large: 5000000 bytes")
+}
+
+func TestDataSourceFile_Directory(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/get-status?path=%2Fa%2Fb",
+				Response: FileInfo{
+					Path:  "/a/b",
+					IsDir: true,
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceDBFSFile(),
+		ID:          ".",
+		HCL:         `path = "/a/b"`,
+	}.ExpectError(t, "/a/b is a directory")
+}
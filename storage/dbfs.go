@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"io"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 )
@@ -56,6 +57,12 @@ type DbfsAPI struct {
 
 // Create creates a file on DBFS
 func (a DbfsAPI) Create(path string, byteArr []byte, overwrite bool) (err error) {
+	return a.CreateFromReader(path, bytes.NewReader(byteArr), overwrite)
+}
+
+// CreateFromReader streams content of a reader to a file on DBFS in blocks of
+// one megabyte, so that files of any size could be uploaded without loading them into memory
+func (a DbfsAPI) CreateFromReader(path string, reader io.Reader, overwrite bool) (err error) {
 	handle, err := a.createHandle(path, overwrite)
 	if err != nil {
 		return
@@ -66,19 +73,23 @@ func (a DbfsAPI) Create(path string, byteArr []byte, overwrite bool) (err error)
 			err = cerr
 		}
 	}()
-	buffer := bytes.NewBuffer(byteArr)
+	byteChunk := make([]byte, 1e6)
 	for {
-		byteChunk := buffer.Next(1e6)
-		if len(byteChunk) == 0 {
-			break
+		n, rerr := io.ReadFull(reader, byteChunk)
+		if n > 0 {
+			b64Data := base64.StdEncoding.EncodeToString(byteChunk[:n])
+			err = a.addBlock(b64Data, handle)
+			if err != nil {
+				return
+			}
 		}
-		b64Data := base64.StdEncoding.EncodeToString(byteChunk)
-		err = a.addBlock(b64Data, handle)
-		if err != nil {
+		if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
 			return
 		}
+		if rerr != nil {
+			return rerr
+		}
 	}
-	return
 }
 
 func (a DbfsAPI) createHandle(path string, overwrite bool) (int64, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/crc32"
 	"log"
 	"reflect"
 	"regexp"
@@ -93,8 +94,16 @@ func (mp MountPoint) Mount(mo Mount) (source string, err error) {
 	return result.Text(), result.Err()
 }
 
+// mountTimeouts are shared by all mount resources, as every operation may need
+// to start the mounting cluster and run a command on it
+func mountTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Default: schema.DefaultTimeout(compute.DefaultProvisionTimeout),
+	}
+}
+
 func commonMountResource(tpl Mount, s map[string]*schema.Schema) *schema.Resource {
-	resource := &schema.Resource{Schema: s, SchemaVersion: 2}
+	resource := &schema.Resource{Schema: s, SchemaVersion: 2, Timeouts: mountTimeouts()}
 	// nolint should be a bigger context-aware refactor
 	resource.CreateContext = mountCreate(tpl, resource)
 	resource.ReadContext = mountRead(tpl, resource)
@@ -142,6 +151,82 @@ func getOrCreateMountingCluster(clustersAPI compute.ClustersAPI) (string, error)
 	return cluster.ClusterID, nil
 }
 
+// MountingCluster customizes the cluster, that is created to perform mount operations
+type MountingCluster struct {
+	ClusterName          string            `json:"cluster_name,omitempty"`
+	SparkVersion         string            `json:"spark_version,omitempty"`
+	NodeTypeID           string            `json:"node_type_id,omitempty"`
+	PolicyID             string            `json:"policy_id,omitempty"`
+	InstanceProfile      string            `json:"instance_profile,omitempty"`
+	GoogleServiceAccount string            `json:"google_service_account,omitempty"`
+	SparkConf            map[string]string `json:"spark_conf,omitempty"`
+	CustomTags           map[string]string `json:"custom_tags,omitempty"`
+}
+
+// name returns configured cluster name or the one, that is derived from specification,
+// so that mounts with the same custom cluster share it
+func (mc MountingCluster) name() string {
+	if mc.ClusterName != "" {
+		return mc.ClusterName
+	}
+	spec, _ := json.Marshal(mc)
+	return fmt.Sprintf("terraform-mount-%08x", crc32.ChecksumIEEE(spec))
+}
+
+func getOrCreateCustomMountingCluster(clustersAPI compute.ClustersAPI,
+	mc MountingCluster) (string, error) {
+	name := mc.name()
+	cluster := compute.Cluster{
+		NumWorkers:             0,
+		ClusterName:            name,
+		SparkVersion:           mc.SparkVersion,
+		NodeTypeID:             mc.NodeTypeID,
+		PolicyID:               mc.PolicyID,
+		AutoterminationMinutes: 10,
+		SparkConf: map[string]string{
+			"spark.master":                     "local[*]",
+			"spark.databricks.cluster.profile": "singleNode",
+		},
+		CustomTags: map[string]string{
+			"ResourceClass": "SingleNode",
+		},
+	}
+	if cluster.SparkVersion == "" {
+		cluster.SparkVersion = clustersAPI.LatestSparkVersionOrDefault(
+			compute.SparkVersionRequest{
+				Latest:          true,
+				LongTermSupport: true,
+			})
+	}
+	if cluster.NodeTypeID == "" {
+		cluster.NodeTypeID = clustersAPI.GetSmallestNodeType(
+			compute.NodeTypeRequest{
+				LocalDisk: true,
+			})
+	}
+	for k, v := range mc.SparkConf {
+		cluster.SparkConf[k] = v
+	}
+	for k, v := range mc.CustomTags {
+		cluster.CustomTags[k] = v
+	}
+	if mc.InstanceProfile != "" {
+		cluster.AwsAttributes = &compute.AwsAttributes{
+			InstanceProfileArn: mc.InstanceProfile,
+		}
+	}
+	if mc.GoogleServiceAccount != "" {
+		cluster.GcpAttributes = &compute.GcpAttributes{
+			GoogleServiceAccount: mc.GoogleServiceAccount,
+		}
+	}
+	info, err := clustersAPI.GetOrCreateRunningCluster(name, cluster)
+	if err != nil {
+		return "", err
+	}
+	return info.ClusterID, nil
+}
+
 func getMountingClusterID(ctx context.Context, client *common.DatabricksClient, clusterID string) (string, error) {
 	clustersAPI := compute.NewClustersAPI(ctx, client)
 	if clusterID == "" {
@@ -200,16 +285,16 @@ func mountCreate(tpl interface{}, r *schema.Resource) func(context.Context, *sch
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		mountConfig, mountPoint, err := mountCluster(ctx, tpl, d, m, r)
 		if err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		log.Printf("[INFO] Mounting %s at /mnt/%s", mountConfig.Source(), d.Id())
 		source, err := mountPoint.Mount(mountConfig)
 		if err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		err = d.Set("source", source)
 		if err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		return readMountSource(ctx, mountPoint, d)
 	}
@@ -224,10 +309,10 @@ func readMountSource(ctx context.Context, mp MountPoint, d *schema.ResourceData)
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return common.DiagnosticsFromErr(err)
 	}
 	if err = d.Set("source", source); err != nil {
-		return diag.FromErr(err)
+		return common.DiagnosticsFromErr(err)
 	}
 	return nil
 }
@@ -237,7 +322,29 @@ func mountRead(tpl Mount, r *schema.Resource) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		_, mp, err := mountCluster(ctx, tpl, d, m, r)
 		if err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
+		}
+		return readMountSource(ctx, mp, d)
+	}
+}
+
+// returns update resource function, that re-mounts storage on the same mount point
+func mountUpdate(tpl Mount, r *schema.Resource) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		mountConfig, mp, err := mountCluster(ctx, tpl, d, m, r)
+		if err != nil {
+			return common.DiagnosticsFromErr(err)
+		}
+		log.Printf("[INFO] Re-mounting %s at /mnt/%s", mountConfig.Source(), d.Id())
+		if err = mp.Delete(); err != nil {
+			return common.DiagnosticsFromErr(err)
+		}
+		source, err := mp.Mount(mountConfig)
+		if err != nil {
+			return common.DiagnosticsFromErr(err)
+		}
+		if err = d.Set("source", source); err != nil {
+			return common.DiagnosticsFromErr(err)
 		}
 		return readMountSource(ctx, mp, d)
 	}
@@ -248,11 +355,11 @@ func mountDelete(tpl Mount, r *schema.Resource) schema.DeleteContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		_, mp, err := mountCluster(ctx, tpl, d, m, r)
 		if err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		log.Printf("[INFO] Unmounting /mnt/%s", d.Id())
 		if err = mp.Delete(); err != nil {
-			return diag.FromErr(err)
+			return common.DiagnosticsFromErr(err)
 		}
 		return nil
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/stretchr/testify/assert"
@@ -301,3 +302,17 @@ func TestDeletedMountClusterRecreates(t *testing.T) {
 		assert.Equal(t, "bcd", clusterID)
 	})
 }
+
+func TestMountResourcesHaveTimeouts(t *testing.T) {
+	for name, r := range map[string]*schema.Resource{
+		"mount":            ResourceMount(),
+		"aws_s3_mount":     ResourceAWSS3Mount(),
+		"azure_blob_mount": ResourceAzureBlobMount(),
+		"adls_gen1_mount":  ResourceAzureAdlsGen1Mount(),
+		"adls_gen2_mount":  ResourceAzureAdlsGen2Mount(),
+	} {
+		if assert.NotNil(t, r.Timeouts, name) {
+			assert.Equal(t, compute.DefaultProvisionTimeout, *r.Timeouts.Default, name)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package storage
 
 import (
 	"context"
+	"crypto/md5"
 	"fmt"
+	"io"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
@@ -10,6 +12,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// uploadDBFSFile streams file content to DBFS and records its checksum
+func uploadDBFSFile(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+	reader, err := workspace.OpenContent(d)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	hash := md5.New()
+	err = NewDbfsAPI(ctx, c).CreateFromReader(d.Get("path").(string),
+		io.TeeReader(reader, hash), true)
+	if err != nil {
+		return err
+	}
+	return d.Set("md5", fmt.Sprintf("%x", hash.Sum(nil)))
+}
+
 // ResourceDBFSFile manages files on DBFS
 func ResourceDBFSFile() *schema.Resource {
 	return common.Resource{
@@ -25,15 +43,10 @@ func ResourceDBFSFile() *schema.Resource {
 			},
 		}),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			path := d.Get("path").(string)
-			content, err := workspace.ReadContent(d)
-			if err != nil {
-				return err
-			}
-			if err = NewDbfsAPI(ctx, c).Create(path, content, true); err != nil {
+			if err := uploadDBFSFile(ctx, d, c); err != nil {
 				return err
 			}
-			d.SetId(path)
+			d.SetId(d.Get("path").(string))
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -47,6 +60,7 @@ func ResourceDBFSFile() *schema.Resource {
 			d.Set("file_size", fileInfo.FileSize)
 			return nil
 		},
+		Update: uploadDBFSFile,
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewDbfsAPI(ctx, c).Delete(d.Id(), false)
 		},
@@ -1,11 +1,15 @@
 package storage
 
 import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
 
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
 )
@@ -217,3 +221,142 @@ func TestDBFSFileRead_IsMissingResource(t *testing.T) {
 		},
 	}.ApplyNoError(t)
 }
+
+func TestDBFSFileCreate_Chunked(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), 1500000)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/dbfs/create",
+				ExpectedRequest: CreateHandle{
+					Path:      "/large",
+					Overwrite: true,
+				},
+				Response: Handle{123},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/dbfs/add-block",
+				ExpectedRequest: AddBlock{
+					Data:   base64.StdEncoding.EncodeToString(content[:1000000]),
+					Handle: 123,
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/dbfs/add-block",
+				ExpectedRequest: AddBlock{
+					Data:   base64.StdEncoding.EncodeToString(content[1000000:]),
+					Handle: 123,
+				},
+			},
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/dbfs/close",
+				ExpectedRequest: Handle{123},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/dbfs/get-status?path=%2Flarge",
+				Response: FileInfo{
+					Path:     "/large",
+					FileSize: 1500000,
+				},
+			},
+		},
+		Resource: ResourceDBFSFile(),
+		Create:   true,
+		State: map[string]interface{}{
+			"content_base64": base64.StdEncoding.EncodeToString(content),
+			"path":           "/large",
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/large", d.Id())
+	assert.Equal(t, fmt.Sprintf("%x", md5.Sum(content)), d.Get("md5"))
+	assert.Equal(t, 1500000, d.Get("file_size"))
+}
+
+func TestDBFSFileUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/dbfs/create",
+				ExpectedRequest: CreateHandle{
+					Path:      "/abc",
+					Overwrite: true,
+				},
+				Response: Handle{123},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/dbfs/add-block",
+				ExpectedRequest: AddBlock{
+					Data:   "YWJjZGU=",
+					Handle: 123,
+				},
+			},
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/dbfs/close",
+				ExpectedRequest: Handle{123},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/dbfs/get-status?path=%2Fabc",
+				Response: FileInfo{
+					Path:     "/abc",
+					FileSize: 5,
+				},
+			},
+		},
+		Resource: ResourceDBFSFile(),
+		Update:   true,
+		ID:       "/abc",
+		InstanceState: map[string]string{
+			"path":           "/abc",
+			"content_base64": "YWJj",
+			"md5":            "900150983cd24fb0d6963f7d28e17f72",
+		},
+		State: map[string]interface{}{
+			"content_base64": "YWJjZGU=",
+			"path":           "/abc",
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/abc", d.Id())
+	assert.Equal(t, "ab56b4d92b40713acc5af89985d4b786", d.Get("md5"))
+}
+
+func TestDBFSFileCreate_AddBlockError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/dbfs/create",
+				Response: Handle{123},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/dbfs/add-block",
+				Status:   400,
+				Response: common.APIErrorBody{
+					ErrorCode: "MAX_BLOCK_SIZE_EXCEEDED",
+					Message:   "Block is too big",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/dbfs/close",
+			},
+		},
+		Resource: ResourceDBFSFile(),
+		Create:   true,
+		State: map[string]interface{}{
+			"content_base64": "YWJjZGU=",
+			"path":           "/abc",
+		},
+	}.ExpectError(t, "Block is too big")
+}
@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/workspace"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DirectoryEntry is a file or a directory within Unity Catalog volume
+type DirectoryEntry struct {
+	Path         string `json:"path"`
+	Name         string `json:"name,omitempty"`
+	IsDirectory  bool   `json:"is_directory,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+	LastModified int64  `json:"last_modified,omitempty"`
+}
+
+type directoryContents struct {
+	Contents      []DirectoryEntry `json:"contents,omitempty"`
+	NextPageToken string           `json:"next_page_token,omitempty"`
+}
+
+// NewFilesAPI creates FilesAPI instance from provider meta
+func NewFilesAPI(ctx context.Context, m interface{}) FilesAPI {
+	return FilesAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// FilesAPI exposes the Files API, that works with Unity Catalog volumes
+type FilesAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func escapePath(p string) string {
+	return (&url.URL{Path: p}).EscapedPath()
+}
+
+// Upload creates or overwrites file on a given path
+func (a FilesAPI) Upload(p string, content []byte) error {
+	_, err := a.client.Raw(a.context, http.MethodPut,
+		fmt.Sprintf("/fs/files%s?overwrite=true", escapePath(p)), content)
+	return err
+}
+
+// Status returns metadata of a file, that is looked up in its parent directory
+func (a FilesAPI) Status(p string) (entry DirectoryEntry, err error) {
+	parent := path.Dir(p)
+	var page directoryContents
+	// first page is requested without query parameters
+	var request interface{}
+	for {
+		err = a.client.Get(a.context, fmt.Sprintf("/fs/directories%s", escapePath(parent)), request, &page)
+		if err != nil {
+			return
+		}
+		for _, v := range page.Contents {
+			if v.Path == p && !v.IsDirectory {
+				return v, nil
+			}
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		request = map[string]string{
+			"page_token": page.NextPageToken,
+		}
+		page = directoryContents{}
+	}
+	err = common.NotFound(fmt.Sprintf("file %s not found", p))
+	return
+}
+
+// Delete removes file on a given path
+func (a FilesAPI) Delete(p string) error {
+	_, err := a.client.Raw(a.context, http.MethodDelete,
+		fmt.Sprintf("/fs/files%s", escapePath(p)), nil)
+	return err
+}
+
+// ResourceFile manages files in Unity Catalog volumes
+func ResourceFile() *schema.Resource {
+	s := workspace.FileContentSchema(map[string]*schema.Schema{
+		"file_size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"modification_time": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	})
+	validatePath := s["path"].ValidateDiagFunc
+	s["path"].ValidateDiagFunc = func(i interface{}, p cty.Path) diag.Diagnostics {
+		if !strings.HasPrefix(i.(string), "/Volumes/") {
+			return diag.Diagnostics{
+				{
+					Summary:       "Path must start with /Volumes/",
+					Severity:      diag.Error,
+					AttributePath: p,
+				},
+			}
+		}
+		return validatePath(i, p)
+	}
+	upload := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		content, err := workspace.ReadContent(d)
+		if err != nil {
+			return err
+		}
+		// metadata of uploaded file is recorded on the next read
+		d.Set("modification_time", 0)
+		return NewFilesAPI(ctx, c).Upload(d.Get("path").(string), content)
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if err := upload(ctx, d, c); err != nil {
+				return err
+			}
+			d.SetId(d.Get("path").(string))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			entry, err := NewFilesAPI(ctx, c).Status(d.Id())
+			if err != nil {
+				return err
+			}
+			knownSize := d.Get("file_size").(int)
+			knownTime := d.Get("modification_time").(int)
+			if knownTime != 0 && (int64(knownSize) != entry.FileSize ||
+				int64(knownTime) != entry.LastModified) {
+				log.Printf("[INFO] File %s was changed outside of Terraform", d.Id())
+				// forces the diff with local content checksum
+				d.Set("md5", "different")
+			}
+			d.Set("path", entry.Path)
+			d.Set("file_size", entry.FileSize)
+			d.Set("modification_time", entry.LastModified)
+			return nil
+		},
+		Update: upload,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewFilesAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
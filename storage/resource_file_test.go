@@ -0,0 +1,224 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+var volumeFileStatus = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/fs/directories/Volumes/main/default/vol",
+	Response: directoryContents{
+		Contents: []DirectoryEntry{
+			{
+				Path:        "/Volumes/main/default/vol/dir",
+				IsDirectory: true,
+			},
+			{
+				Path:         "/Volumes/main/default/vol/a.json",
+				FileSize:     7,
+				LastModified: 1234,
+			},
+		},
+	},
+}
+
+func TestResourceFileCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "PUT",
+				Resource:        "/api/2.0/fs/files/Volumes/main/default/vol/a.json?overwrite=true",
+				ExpectedRequest: map[string]int{"a": 1},
+			},
+			volumeFileStatus,
+		},
+		Resource: ResourceFile(),
+		State: map[string]interface{}{
+			"path":           "/Volumes/main/default/vol/a.json",
+			"content_base64": "eyJhIjoxfQ==",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/Volumes/main/default/vol/a.json", d.Id())
+	assert.Equal(t, 7, d.Get("file_size"))
+	assert.Equal(t, 1234, d.Get("modification_time"))
+}
+
+func TestResourceFileCreate_NotVolume(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceFile(),
+		State: map[string]interface{}{
+			"path":           "/FileStore/a.json",
+			"content_base64": "eyJhIjoxfQ==",
+		},
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [path] Path must start with /Volumes/")
+}
+
+func TestResourceFileCreate_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/vol/a.json?overwrite=true",
+				Response: common.APIErrorBody{
+					ErrorCode: "PERMISSION_DENIED",
+					Message:   "No access to volume",
+				},
+				Status: 403,
+			},
+		},
+		Resource: ResourceFile(),
+		State: map[string]interface{}{
+			"path":           "/Volumes/main/default/vol/a.json",
+			"content_base64": "eyJhIjoxfQ==",
+		},
+		Create: true,
+	}.ExpectError(t, "No access to volume")
+}
+
+func TestResourceFileRead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{volumeFileStatus},
+		Resource: ResourceFile(),
+		InstanceState: map[string]string{
+			"path":              "/Volumes/main/default/vol/a.json",
+			"md5":               "bb6cb5c68df4652941caf652a366f2d8",
+			"file_size":         "7",
+			"modification_time": "1234",
+		},
+		State: map[string]interface{}{
+			"path":           "/Volumes/main/default/vol/a.json",
+			"content_base64": "eyJhIjoxfQ==",
+		},
+		Read: true,
+		ID:   "/Volumes/main/default/vol/a.json",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "bb6cb5c68df4652941caf652a366f2d8", d.Get("md5"))
+}
+
+func TestResourceFileRead_Drift(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{volumeFileStatus},
+		Resource: ResourceFile(),
+		InstanceState: map[string]string{
+			"path":              "/Volumes/main/default/vol/a.json",
+			"md5":               "bb6cb5c68df4652941caf652a366f2d8",
+			"file_size":         "7",
+			"modification_time": "1000",
+		},
+		State: map[string]interface{}{
+			"path":           "/Volumes/main/default/vol/a.json",
+			"content_base64": "eyJhIjoxfQ==",
+		},
+		Read: true,
+		ID:   "/Volumes/main/default/vol/a.json",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "different", d.Get("md5"))
+	assert.Equal(t, 1234, d.Get("modification_time"))
+}
+
+func TestResourceFileRead_Paginated(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/fs/directories/Volumes/main/default/vol",
+				Response: directoryContents{
+					Contents: []DirectoryEntry{
+						{
+							Path: "/Volumes/main/default/vol/b.json",
+						},
+					},
+					NextPageToken: "next",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/fs/directories/Volumes/main/default/vol?page_token=next",
+				Response: directoryContents{
+					Contents: []DirectoryEntry{
+						{
+							Path:     "/Volumes/main/default/vol/a.json",
+							FileSize: 7,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Read:     true,
+		ID:       "/Volumes/main/default/vol/a.json",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 7, d.Get("file_size"))
+}
+
+func TestResourceFileRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/fs/directories/Volumes/main/default/vol",
+				Response: directoryContents{},
+			},
+		},
+		Resource: ResourceFile(),
+		Read:     true,
+		Removed:  true,
+		ID:       "/Volumes/main/default/vol/a.json",
+	}.ApplyNoError(t)
+}
+
+func TestResourceFileUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          "PUT",
+				Resource:        "/api/2.0/fs/files/Volumes/main/default/vol/a.json?overwrite=true",
+				ExpectedRequest: map[string]int{"a": 1},
+			},
+			volumeFileStatus,
+		},
+		Resource: ResourceFile(),
+		InstanceState: map[string]string{
+			"path":              "/Volumes/main/default/vol/a.json",
+			"content_base64":    "eyJhIjoyfQ==",
+			"md5":               "bb6cb5c68df4652941caf652a366f2d8",
+			"file_size":         "7",
+			"modification_time": "1000",
+		},
+		State: map[string]interface{}{
+			"path":           "/Volumes/main/default/vol/a.json",
+			"content_base64": "eyJhIjoxfQ==",
+		},
+		Update: true,
+		ID:     "/Volumes/main/default/vol/a.json",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "bb6cb5c68df4652941caf652a366f2d8", d.Get("md5"))
+	assert.Equal(t, 1234, d.Get("modification_time"))
+}
+
+func TestResourceFileDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/fs/files/Volumes/main/default/vol/a.json",
+			},
+		},
+		Resource: ResourceFile(),
+		Delete:   true,
+		ID:       "/Volumes/main/default/vol/a.json",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/Volumes/main/default/vol/a.json", d.Id())
+}
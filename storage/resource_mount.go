@@ -0,0 +1,245 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/compute"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// S3IamMount describes S3 bucket, that is mounted with instance profile of mounting cluster
+type S3IamMount struct {
+	BucketName      string `json:"bucket_name"`
+	InstanceProfile string `json:"instance_profile,omitempty"`
+}
+
+// Source returns S3A URI backing the mount
+func (m S3IamMount) Source() string {
+	return fmt.Sprintf("s3a://%s", m.BucketName)
+}
+
+// Config returns mount configurations
+func (m S3IamMount) Config() map[string]string {
+	return map[string]string{}
+}
+
+// GSMount describes Google Cloud Storage bucket mount, that is accessed with
+// Google service account of mounting cluster
+type GSMount struct {
+	BucketName     string `json:"bucket_name"`
+	ServiceAccount string `json:"service_account,omitempty"`
+}
+
+// Source returns GS URI backing the mount
+func (m GSMount) Source() string {
+	return fmt.Sprintf("gs://%s", m.BucketName)
+}
+
+// Config returns mount configurations
+func (m GSMount) Config() map[string]string {
+	return map[string]string{}
+}
+
+// GenericMount describes mount of any object storage, either by URI with extra configs or by one of typed blocks
+type GenericMount struct {
+	URI          string              `json:"uri,omitempty"`
+	ExtraConfigs map[string]string   `json:"extra_configs,omitempty"`
+	S3           *S3IamMount         `json:"s3,omitempty"`
+	Abfs         *AzureADLSGen2Mount `json:"abfs,omitempty"`
+	Wasb         *AzureBlobMount     `json:"wasb,omitempty"`
+	Adl          *AzureADLSGen1Mount `json:"adl,omitempty"`
+	Gs           *GSMount            `json:"gs,omitempty"`
+}
+
+func (m GenericMount) typed() Mount {
+	switch {
+	case m.S3 != nil:
+		return m.S3
+	case m.Abfs != nil:
+		return m.Abfs
+	case m.Wasb != nil:
+		return m.Wasb
+	case m.Adl != nil:
+		return m.Adl
+	case m.Gs != nil:
+		return m.Gs
+	}
+	return nil
+}
+
+// Source returns URI backing the mount
+func (m GenericMount) Source() string {
+	if m.URI != "" {
+		return m.URI
+	}
+	if t := m.typed(); t != nil {
+		return t.Source()
+	}
+	return ""
+}
+
+// Config returns mount configurations of typed block, overridden by extra configs
+func (m GenericMount) Config() map[string]string {
+	config := map[string]string{}
+	if t := m.typed(); t != nil {
+		for k, v := range t.Config() {
+			config[k] = v
+		}
+	}
+	for k, v := range m.ExtraConfigs {
+		config[k] = v
+	}
+	return config
+}
+
+// ResourceMount mounts object storage by URI or typed configuration
+func ResourceMount() *schema.Resource {
+	tpl := GenericMount{}
+	s := common.StructToSchema(tpl, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["cluster_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		}
+		s["mount_name"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		}
+		s["source"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+		s["cluster"] = &schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"cluster_id"},
+			Elem: &schema.Resource{
+				Schema: common.StructToSchema(MountingCluster{},
+					func(m map[string]*schema.Schema) map[string]*schema.Schema {
+						return m
+					}),
+			},
+		}
+		blocks := []string{"uri", "s3", "abfs", "wasb", "adl", "gs"}
+		for _, k := range blocks {
+			s[k].ExactlyOneOf = blocks
+		}
+		abfs := s["abfs"].Elem.(*schema.Resource).Schema
+		abfs["directory"].ValidateFunc = ValidateMountDirectory
+		wasb := s["wasb"].Elem.(*schema.Resource).Schema
+		wasb["directory"].Required = false
+		wasb["directory"].Optional = true
+		wasb["directory"].Default = "/"
+		wasb["directory"].ValidateFunc = ValidateMountDirectory
+		wasb["auth_type"].ValidateFunc = validation.StringInSlice([]string{"SAS", "ACCESS_KEY"}, false)
+		wasb["token_secret_key"].Sensitive = true
+		// extra_configs may contain storage keys or client secrets
+		s["extra_configs"].Sensitive = true
+		adl := s["adl"].Elem.(*schema.Resource).Schema
+		adl["directory"].ValidateFunc = ValidateMountDirectory
+		adl["spark_conf_prefix"].Required = false
+		adl["spark_conf_prefix"].Optional = true
+		adl["spark_conf_prefix"].Default = "fs.adl"
+		adl["spark_conf_prefix"].ValidateFunc = validation.StringInSlice([]string{"fs.adl", "dfs.adls"}, false)
+		var forceNew func(s map[string]*schema.Schema)
+		forceNew = func(s map[string]*schema.Schema) {
+			for _, v := range s {
+				if v.Computed && !v.Optional {
+					continue
+				}
+				v.ForceNew = true
+				if nested, ok := v.Elem.(*schema.Resource); ok {
+					forceNew(nested.Schema)
+				}
+			}
+		}
+		forceNew(s)
+		return s
+	})
+	r := &schema.Resource{
+		Schema:        s,
+		SchemaVersion: 2,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: mountTimeouts(),
+	}
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := preprocessGenericMount(ctx, s, d, m); err != nil {
+			return common.DiagnosticsFromErr(err)
+		}
+		return mountCreate(tpl, r)(ctx, d, m)
+	}
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := preprocessGenericMount(ctx, s, d, m); err != nil {
+			return common.DiagnosticsFromErr(err)
+		}
+		return mountRead(tpl, r)(ctx, d, m)
+	}
+	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := preprocessGenericMount(ctx, s, d, m); err != nil {
+			return common.DiagnosticsFromErr(err)
+		}
+		return mountDelete(tpl, r)(ctx, d, m)
+	}
+	return r
+}
+
+// preprocessGenericMount starts mounting cluster from custom specification,
+// with instance profile for S3 mounts or with service account for GCS mounts
+func preprocessGenericMount(ctx context.Context, s map[string]*schema.Schema,
+	d *schema.ResourceData, m interface{}) error {
+	instanceProfile := d.Get("s3.0.instance_profile").(string)
+	serviceAccount := d.Get("gs.0.service_account").(string)
+	if _, ok := d.GetOk("cluster"); ok {
+		var spec struct {
+			Cluster *MountingCluster `json:"cluster,omitempty"`
+		}
+		if err := common.DataToStructPointer(d, s, &spec); err != nil {
+			return err
+		}
+		if spec.Cluster.InstanceProfile == "" {
+			spec.Cluster.InstanceProfile = instanceProfile
+		}
+		if spec.Cluster.GoogleServiceAccount == "" {
+			spec.Cluster.GoogleServiceAccount = serviceAccount
+		}
+		clusterID, err := getOrCreateCustomMountingCluster(
+			compute.NewClustersAPI(ctx, m), *spec.Cluster)
+		if err != nil {
+			return err
+		}
+		return d.Set("cluster_id", clusterID)
+	}
+	if d.Get("cluster_id").(string) != "" {
+		return nil
+	}
+	if serviceAccount != "" {
+		clusterID, err := getOrCreateCustomMountingCluster(
+			compute.NewClustersAPI(ctx, m), MountingCluster{
+				ClusterName: fmt.Sprintf("terraform-mount-gcs-%s",
+					strings.Split(serviceAccount, "@")[0]),
+				GoogleServiceAccount: serviceAccount,
+			})
+		if err != nil {
+			return err
+		}
+		return d.Set("cluster_id", clusterID)
+	}
+	if instanceProfile == "" {
+		return nil
+	}
+	cluster, err := GetOrCreateMountingClusterWithInstanceProfile(
+		compute.NewClustersAPI(ctx, m), instanceProfile)
+	if err != nil {
+		return err
+	}
+	return d.Set("cluster_id", cluster.ClusterID)
+}
@@ -0,0 +1,479 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/compute"
+	"github.com/databrickslabs/terraform-provider-databricks/internal"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test interface compliance via compile time error
+var _ Mount = (*GenericMount)(nil)
+
+var runningMountCluster = qa.HTTPFixture{
+	Method:       "GET",
+	ReuseRequest: true,
+	Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+	Response: compute.ClusterInfo{
+		ClusterID: "this_cluster",
+		State:     compute.ClusterStateRunning,
+	},
+}
+
+func TestGenericMount_Source(t *testing.T) {
+	assert.Equal(t, "s3a://abc", GenericMount{URI: "s3a://abc"}.Source())
+	assert.Equal(t, "s3a://abc", GenericMount{S3: &S3IamMount{BucketName: "abc"}}.Source())
+	assert.Equal(t, "gs://abc", GenericMount{Gs: &GSMount{BucketName: "abc"}}.Source())
+	assert.Equal(t, "abfss://c@sa.dfs.core.windows.net/d", GenericMount{
+		Abfs: &AzureADLSGen2Mount{
+			ContainerName:      "c",
+			StorageAccountName: "sa",
+			Directory:          "/d",
+		}}.Source())
+	assert.Equal(t, "wasbs://c@sa.blob.core.windows.net/", GenericMount{
+		Wasb: &AzureBlobMount{
+			ContainerName:      "c",
+			StorageAccountName: "sa",
+			Directory:          "/",
+		}}.Source())
+	assert.Equal(t, "adl://r.azuredatalakestore.net", GenericMount{
+		Adl: &AzureADLSGen1Mount{
+			StorageResource: "r",
+		}}.Source())
+	assert.Equal(t, "", GenericMount{}.Source())
+}
+
+func TestGenericMount_Config(t *testing.T) {
+	config := GenericMount{
+		Wasb: &AzureBlobMount{
+			ContainerName:      "c",
+			StorageAccountName: "sa",
+			AuthType:           "ACCESS_KEY",
+			SecretScope:        "s",
+			SecretKey:          "k",
+		},
+		ExtraConfigs: map[string]string{
+			"fs.azure.account.key.sa.blob.core.windows.net": "{secrets/a/b}",
+			"a": "b",
+		},
+	}.Config()
+	assert.Equal(t, map[string]string{
+		"fs.azure.account.key.sa.blob.core.windows.net": "{secrets/a/b}",
+		"a": "b",
+	}, config)
+	assert.Equal(t, map[string]string{}, GenericMount{URI: "s3a://abc"}.Config())
+}
+
+func TestResourceMountCreate_URI(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{runningMountCluster},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"abfss://c@sa.dfs.core.windows.net"`)
+				assert.Contains(t, trunc, `"fs.azure.account.auth.type":"OAuth"`)
+				assert.Contains(t, trunc, `dbutils.secrets.get("scope", "key")`)
+			}
+			assert.Contains(t, trunc, "/mnt/this_mount")
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "abfss://c@sa.dfs.core.windows.net",
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id": "this_cluster",
+			"mount_name": "this_mount",
+			"uri":        "abfss://c@sa.dfs.core.windows.net",
+			"extra_configs": map[string]interface{}{
+				"fs.azure.account.auth.type":            "OAuth",
+				"fs.azure.account.oauth2.client.secret": "{secrets/scope/key}",
+			},
+		},
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "this_mount", d.Id())
+	assert.Equal(t, "abfss://c@sa.dfs.core.windows.net", d.Get("source"))
+}
+
+func TestResourceMountCreate_Abfs(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{runningMountCluster},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"abfss://c@sa.dfs.core.windows.net/d"`)
+				assert.Contains(t, trunc, `"fs.azure.account.oauth2.client.id":"cid"`)
+				assert.Contains(t, trunc, `dbutils.secrets.get("scope", "key")`)
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "abfss://c@sa.dfs.core.windows.net/d",
+			}
+		},
+		HCL: `
+		cluster_id = "this_cluster"
+		mount_name = "this_mount"
+		abfs {
+			container_name = "c"
+			storage_account_name = "sa"
+			directory = "/d"
+			tenant_id = "tid"
+			client_id = "cid"
+			client_secret_scope = "scope"
+			client_secret_key = "key"
+			initialize_file_system = false
+		}`,
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abfss://c@sa.dfs.core.windows.net/d", d.Get("source"))
+}
+
+func TestResourceMountCreate_S3InstanceProfile(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Response: compute.ClusterList{
+					Clusters: []compute.ClusterInfo{
+						{
+							ClusterID:   "mount_cluster",
+							ClusterName: "terraform-mount-s3-access",
+							State:       compute.ClusterStateRunning,
+						},
+					},
+				},
+				ReuseRequest: true,
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/spark-versions",
+				Response: compute.SparkVersionsList{
+					SparkVersions: []compute.SparkVersion{
+						{
+							Version:     "7.3.x-scala2.12",
+							Description: "7.3 LTS (includes Apache Spark 3.0.1, Scala 2.12)",
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response: compute.NodeTypeList{
+					NodeTypes: []compute.NodeType{
+						{
+							NodeTypeID: "m5d.large",
+							MemoryMB:   8192,
+							NumCores:   2,
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=mount_cluster",
+				ReuseRequest: true,
+				Response: compute.ClusterInfo{
+					ClusterID: "mount_cluster",
+					State:     compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"s3a://bucket"`)
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "s3a://bucket",
+			}
+		},
+		HCL: `
+		mount_name = "this_mount"
+		s3 {
+			bucket_name = "bucket"
+			instance_profile = "arn:aws:iam::999999999999:instance-profile/s3-access"
+		}`,
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "mount_cluster", d.Get("cluster_id"))
+	assert.Equal(t, "s3a://bucket", d.Get("source"))
+}
+
+func TestResourceMountCreate_CustomCluster(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/list",
+				Response:     compute.ClusterList{},
+				ReuseRequest: true,
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/spark-versions",
+				Response:     compute.SparkVersionsList{},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response:     compute.NodeTypeList{},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: compute.Cluster{
+					ClusterName:            "mounter",
+					SparkVersion:           "7.3.x-scala2.12",
+					NodeTypeID:             "i3.xlarge",
+					PolicyID:               "abc",
+					AutoterminationMinutes: 10,
+					SparkConf: map[string]string{
+						"spark.master":                     "local[*]",
+						"spark.databricks.cluster.profile": "singleNode",
+						"spark.hadoop.fs.s3a.endpoint":     "s3.internal",
+					},
+					CustomTags: map[string]string{
+						"ResourceClass": "SingleNode",
+					},
+					AwsAttributes: &compute.AwsAttributes{
+						InstanceProfileArn: "arn:aws:iam::999999999999:instance-profile/s3-access",
+					},
+				},
+				Response: compute.ClusterInfo{
+					ClusterID: "mounter",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=mounter",
+				ReuseRequest: true,
+				Response: compute.ClusterInfo{
+					ClusterID: "mounter",
+					State:     compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "s3a://bucket",
+			}
+		},
+		HCL: `
+		mount_name = "this_mount"
+		s3 {
+			bucket_name = "bucket"
+			instance_profile = "arn:aws:iam::999999999999:instance-profile/s3-access"
+		}
+		cluster {
+			cluster_name = "mounter"
+			spark_version = "7.3.x-scala2.12"
+			node_type_id = "i3.xlarge"
+			policy_id = "abc"
+			spark_conf = {
+				"spark.hadoop.fs.s3a.endpoint" = "s3.internal"
+			}
+		}`,
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "mounter", d.Get("cluster_id"))
+	assert.Equal(t, "s3a://bucket", d.Get("source"))
+}
+
+func TestResourceMountCreate_GcsServiceAccount(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/list",
+				Response:     compute.ClusterList{},
+				ReuseRequest: true,
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/spark-versions",
+				Response: compute.SparkVersionsList{
+					SparkVersions: []compute.SparkVersion{
+						{
+							Version:     "7.3.x-scala2.12",
+							Description: "7.3 LTS (includes Apache Spark 3.0.1, Scala 2.12)",
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response: compute.NodeTypeList{
+					NodeTypes: []compute.NodeType{
+						{
+							NodeTypeID: "n1-standard-4",
+							MemoryMB:   15360,
+							NumCores:   4,
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: compute.Cluster{
+					ClusterName:            "terraform-mount-gcs-mounter",
+					SparkVersion:           "7.3.x-scala2.12",
+					NodeTypeID:             "n1-standard-4",
+					AutoterminationMinutes: 10,
+					SparkConf: map[string]string{
+						"spark.master":                     "local[*]",
+						"spark.databricks.cluster.profile": "singleNode",
+					},
+					CustomTags: map[string]string{
+						"ResourceClass": "SingleNode",
+					},
+					GcpAttributes: &compute.GcpAttributes{
+						GoogleServiceAccount: "mounter@project.iam.gserviceaccount.com",
+					},
+				},
+				Response: compute.ClusterInfo{
+					ClusterID: "gcs_mounter",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=gcs_mounter",
+				ReuseRequest: true,
+				Response: compute.ClusterInfo{
+					ClusterID: "gcs_mounter",
+					State:     compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"gs://bucket"`)
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "gs://bucket",
+			}
+		},
+		HCL: `
+		mount_name = "this_mount"
+		gs {
+			bucket_name = "bucket"
+			service_account = "mounter@project.iam.gserviceaccount.com"
+		}`,
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "gcs_mounter", d.Get("cluster_id"))
+	assert.Equal(t, "gs://bucket", d.Get("source"))
+}
+
+func TestResourceMountCreate_ClusterConflictsWithClusterID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMount(),
+		HCL: `
+		mount_name = "this_mount"
+		cluster_id = "abc"
+		uri = "s3a://bucket"
+		cluster {
+			node_type_id = "i3.xlarge"
+		}`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [cluster] Conflicting configuration arguments")
+}
+
+func TestMountingClusterName(t *testing.T) {
+	assert.Equal(t, "custom", MountingCluster{ClusterName: "custom"}.name())
+	a := MountingCluster{NodeTypeID: "i3.xlarge"}.name()
+	b := MountingCluster{NodeTypeID: "m5d.large"}.name()
+	assert.True(t, strings.HasPrefix(a, "terraform-mount-"))
+	assert.NotEqual(t, a, b)
+}
+
+func TestResourceMountCreate_NothingSpecified(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMount(),
+		State: map[string]interface{}{
+			"mount_name": "this_mount",
+		},
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [abfs] Invalid combination of arguments. "+
+		"[adl] Invalid combination of arguments. [gs] Invalid combination of arguments. "+
+		"[s3] Invalid combination of arguments. [uri] Invalid combination of arguments. "+
+		"[wasb] Invalid combination of arguments")
+}
+
+func TestResourceMountRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{runningMountCluster},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "error",
+				Summary:    "Mount not found",
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id": "this_cluster",
+			"mount_name": "this_mount",
+			"uri":        "gs://bucket",
+		},
+		ID:      "this_mount",
+		Read:    true,
+		Removed: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceMountDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{runningMountCluster},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			assert.Contains(t, trunc, "dbutils.fs.unmount(mount_point)")
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "success",
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id": "this_cluster",
+			"mount_name": "this_mount",
+			"gs": []interface{}{
+				map[string]interface{}{
+					"bucket_name": "bucket",
+				},
+			},
+		},
+		ID:     "this_mount",
+		Delete: true,
+	}.ApplyNoError(t)
+}
@@ -25,6 +25,7 @@ func DataSourceNotebook() *schema.Resource {
 				string(DBC),
 				string(Source),
 				string(HTML),
+				string(Jupyter),
 			}, false),
 		},
 		"content": {
@@ -55,18 +56,18 @@ func DataSourceNotebook() *schema.Resource {
 			format := d.Get("format").(string)
 			notebookContent, err := notebooksAPI.Export(path, ExportFormat(format))
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			d.SetId(path)
 			// nolint
 			d.Set("content", notebookContent)
 			objectStatus, err := notebooksAPI.Read(d.Id())
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			err = common.StructToData(objectStatus, s, d)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			return nil
 		},
@@ -3,6 +3,7 @@ package workspace
 import (
 	"context"
 
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -15,14 +16,14 @@ func DataSourceNotebookPaths() *schema.Resource {
 			recursive := d.Get("recursive").(bool)
 			notebookList, err := NewNotebooksAPI(ctx, m).List(path, recursive)
 			if err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			d.SetId(path)
 			if err = d.Set("recursive", recursive); err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			if err = d.Set("path", path); err != nil {
-				return diag.FromErr(err)
+				return common.DiagnosticsFromErr(err)
 			}
 			var notebookPathList []map[string]string
 			for _, v := range notebookList {
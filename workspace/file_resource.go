@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"hash/fnv"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -50,6 +51,32 @@ func ReadContent(d *schema.ResourceData) (content []byte, err error) {
 	return
 }
 
+// OpenContent returns reader of `content_base64` or `source` properties, so that
+// content could be streamed instead of being loaded into memory
+func OpenContent(d *schema.ResourceData) (io.ReadCloser, error) {
+	b64 := d.Get("content_base64").(string)
+	if b64 != "" {
+		return ioutil.NopCloser(base64.NewDecoder(base64.StdEncoding, strings.NewReader(b64))), nil
+	}
+	source := d.Get("source").(string)
+	log.Printf("[INFO] Opening %s", source)
+	return os.Open(source)
+}
+
+// ContentChecksum streams content of `content_base64` or `source` properties through MD5 hash
+func ContentChecksum(d *schema.ResourceData) (string, error) {
+	reader, err := OpenContent(d)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	hash := md5.New()
+	if _, err = io.Copy(hash, reader); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
 // MigrateV0 migrates from version 0.2.x state
 func MigrateV0(ctx context.Context,
 	rawState map[string]interface{},
@@ -91,11 +118,12 @@ func FileContentSchemaWithoutPath(extra map[string]*schema.Schema) map[string]*s
 			Default:  "different",
 			Optional: true,
 			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				if _, err := ReadContent(d); err != nil {
+				checksum, err := ContentChecksum(d)
+				if err != nil {
 					return false
 				}
-				log.Printf("[INFO] Suppressing %s diff: %v", d.Id(), old == d.Get("md5"))
-				return old == d.Get("md5")
+				log.Printf("[INFO] Suppressing %s diff: %v", d.Id(), old == checksum)
+				return old == checksum
 			},
 		},
 		"content_base64": {
@@ -2,6 +2,7 @@ package workspace
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 )
@@ -27,17 +28,18 @@ type GlobalInitScriptInfo struct {
 	Name          string `json:"name"`
 	Position      int32  `json:"position,omitempty" tf:"computed"`
 	Enabled       bool   `json:"enabled,omitempty"`
-	CreatedBy     string `json:"creator_user_name,omitempty"`
-	CreatedAt     int64  `json:"created_at_timestamp,omitempty"`
-	UpdatedBy     string `json:"updater_user_name,omitempty"`
-	UpdatedAt     int64  `json:"updated_at_timestamp,omitempty"`
+	CreatedBy     string `json:"created_by,omitempty"`
+	CreatedAt     int64  `json:"created_at,omitempty"`
+	UpdatedBy     string `json:"updated_by,omitempty"`
+	UpdatedAt     int64  `json:"updated_at,omitempty"`
 	ContentBase64 string `json:"script,omitempty"`
 }
 
-// GlobalInitScriptPayload contains information about registered global init script
+// GlobalInitScriptPayload contains information about registered global init script.
+// Script is appended to the end of execution order, when Position is not given.
 type GlobalInitScriptPayload struct {
 	Name          string `json:"name"`
-	Position      int32  `json:"position"`
+	Position      *int32 `json:"position,omitempty"`
 	Enabled       bool   `json:"enabled,omitempty"`
 	ContentBase64 string `json:"script"`
 }
@@ -82,3 +84,29 @@ func (a GlobalInitScriptsAPI) Create(payload GlobalInitScriptPayload) (string, e
 func (a GlobalInitScriptsAPI) Update(scriptID string, payload GlobalInitScriptPayload) error {
 	return a.client.Patch(a.context, "/global-init-scripts/"+scriptID, payload)
 }
+
+// Reorder moves given scripts to the beginning of execution order, so that they run
+// in the same sequence as scriptIDs. Other scripts keep their relative order after them.
+func (a GlobalInitScriptsAPI) Reorder(scriptIDs []string) error {
+	for i, scriptID := range scriptIDs {
+		script, err := a.Get(scriptID)
+		if err != nil {
+			return err
+		}
+		position := int32(i)
+		if script.Position == position {
+			continue
+		}
+		err = a.Update(scriptID, GlobalInitScriptPayload{
+			Name:          script.Name,
+			Position:      &position,
+			Enabled:       script.Enabled,
+			ContentBase64: script.ContentBase64,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot move global init script %s to position %d: %w",
+				scriptID, position, err)
+		}
+	}
+	return nil
+}
@@ -48,7 +48,11 @@ func ResourceDirectory() *schema.Resource {
 			notebooksAPI := NewNotebooksAPI(ctx, c)
 			path := d.Get("path").(string)
 			if err := notebooksAPI.Mkdirs(path); err != nil {
-				// TODO: handle RESOURCE_ALREADY_EXISTS
+				apiErr, ok := err.(common.APIError)
+				if ok && apiErr.ErrorCode == "RESOURCE_ALREADY_EXISTS" {
+					// mkdirs succeeds for existing directories, so there's a notebook or file on this path
+					return fmt.Errorf("cannot create directory %s: %s", path, apiErr.Message)
+				}
 				return err
 			}
 			d.SetId(path)
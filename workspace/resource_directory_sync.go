@@ -0,0 +1,224 @@
+package workspace
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// localFileChecksums walks local directory and returns checksums of files,
+// keyed by slash-separated path relative to the directory. Hidden files and
+// directories, like .git, are skipped.
+func localFileChecksums(dir string) (map[string]string, error) {
+	checksums := map[string]string{}
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != dir && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+		content, err := readFileContent(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		checksums[filepath.ToSlash(rel)] = fmt.Sprintf("%x", md5.Sum(content))
+		return nil
+	})
+	return checksums, err
+}
+
+// syncedObjectPath returns workspace path of a synced file. Notebook sources
+// are imported without file extension, like notebooks created in the UI.
+func syncedObjectPath(root, rel string) string {
+	ext := strings.ToLower(path.Ext(rel))
+	_, isSource := extMap[ext]
+	_, isNotebook := formatExtMap[ext]
+	if isSource || isNotebook {
+		rel = strings.TrimSuffix(rel, path.Ext(rel))
+	}
+	return path.Join(root, rel)
+}
+
+func syncImportRequest(root, rel string, content []byte) ImportRequest {
+	r := ImportRequest{
+		Content:   base64.StdEncoding.EncodeToString(content),
+		Path:      syncedObjectPath(root, rel),
+		Format:    string(Auto),
+		Overwrite: true,
+	}
+	ext := strings.ToLower(path.Ext(rel))
+	if lang, ok := extMap[ext]; ok {
+		r.Format = string(Source)
+		r.Language = lang
+	} else if format, ok := formatExtMap[ext]; ok {
+		r.Format = string(format)
+	}
+	return r
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ResourceDirectorySync uploads local directory tree into workspace
+func ResourceDirectorySync() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"source_dir": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/.+`),
+				"should be an absolute path, beginning with /"),
+		},
+		"files": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"object_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+	sync := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient,
+		known map[string]interface{}) error {
+		sourceDir := d.Get("source_dir").(string)
+		root := d.Get("path").(string)
+		checksums, err := localFileChecksums(sourceDir)
+		if err != nil {
+			return err
+		}
+		current := map[string]interface{}{}
+		for k, v := range checksums {
+			current[k] = v
+		}
+		notebooksAPI := NewNotebooksAPI(ctx, c)
+		if err = notebooksAPI.Mkdirs(root); err != nil {
+			return err
+		}
+		createdDirs := map[string]bool{root: true}
+		for _, rel := range sortedKeys(current) {
+			if known[rel] == current[rel] {
+				continue
+			}
+			parent := path.Dir(path.Join(root, rel))
+			if !createdDirs[parent] {
+				if err = notebooksAPI.Mkdirs(parent); err != nil {
+					return err
+				}
+				createdDirs[parent] = true
+			}
+			content, err := readFileContent(filepath.Join(sourceDir, filepath.FromSlash(rel)))
+			if err != nil {
+				return err
+			}
+			log.Printf("[INFO] Uploading %s to %s", rel, root)
+			if err = notebooksAPI.Create(syncImportRequest(root, rel, content)); err != nil {
+				return err
+			}
+		}
+		for _, rel := range sortedKeys(known) {
+			if _, ok := current[rel]; ok {
+				continue
+			}
+			log.Printf("[INFO] Removing %s from %s, as it's deleted locally", rel, root)
+			err = notebooksAPI.Delete(syncedObjectPath(root, rel), false)
+			if e, ok := err.(common.APIError); ok && e.IsMissing() {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return d.Set("files", current)
+	}
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			sourceDir := d.Get("source_dir").(string)
+			if sourceDir == "" {
+				// source directory is not yet known
+				return nil
+			}
+			checksums, err := localFileChecksums(sourceDir)
+			if err != nil {
+				return err
+			}
+			known := d.Get("files").(map[string]interface{})
+			if len(known) == len(checksums) {
+				changed := false
+				for k, v := range checksums {
+					if known[k] != v {
+						changed = true
+						break
+					}
+				}
+				if !changed {
+					return nil
+				}
+			}
+			return d.SetNew("files", checksums)
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if err := sync(ctx, d, c, map[string]interface{}{}); err != nil {
+				return err
+			}
+			d.SetId(d.Get("path").(string))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			objectStatus, err := NewNotebooksAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			if objectStatus.ObjectType != Directory {
+				return fmt.Errorf("different object type, %s, on this path other than a directory",
+					objectStatus.ObjectType)
+			}
+			d.Set("path", objectStatus.Path)
+			d.Set("object_id", objectStatus.ObjectID)
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			old, _ := d.GetChange("files")
+			return sync(ctx, d, c, old.(map[string]interface{}))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewNotebooksAPI(ctx, c).Delete(d.Id(), true)
+		},
+	}.ToResource()
+}
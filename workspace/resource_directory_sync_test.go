@@ -0,0 +1,245 @@
+package workspace
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func syncTestDir(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "tf-test-directory-sync")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, ioutil.WriteFile(p, []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestLocalFileChecksums(t *testing.T) {
+	dir := syncTestDir(t, map[string]string{
+		"a.py":       "print(1)",
+		"lib/b.sql":  "SELECT 1",
+		".git/HEAD":  "ref: refs/heads/main",
+		".gitignore": "*.pyc",
+	})
+	checksums, err := localFileChecksums(dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"a.py":      "186bdbe41e79ea696410ba0a9e8d2762",
+		"lib/b.sql": "b1698e52a0f16203489454196a0c6307",
+	}, checksums)
+}
+
+func TestSyncImportRequest(t *testing.T) {
+	assert.Equal(t, ImportRequest{
+		Content:   "YQ==",
+		Path:      "/Shared/p/lib/b",
+		Format:    "SOURCE",
+		Language:  "SQL",
+		Overwrite: true,
+	}, syncImportRequest("/Shared/p", "lib/b.sql", []byte("a")))
+	assert.Equal(t, ImportRequest{
+		Content:   "YQ==",
+		Path:      "/Shared/p/Mars",
+		Format:    "JUPYTER",
+		Overwrite: true,
+	}, syncImportRequest("/Shared/p", "Mars.ipynb", []byte("a")))
+	assert.Equal(t, ImportRequest{
+		Content:   "YQ==",
+		Path:      "/Shared/p/conf.json",
+		Format:    "AUTO",
+		Overwrite: true,
+	}, syncImportRequest("/Shared/p", "conf.json", []byte("a")))
+}
+
+var syncDirStatus = qa.HTTPFixture{
+	Method:   http.MethodGet,
+	Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fproject",
+	Response: ObjectStatus{
+		ObjectID:   123,
+		ObjectType: Directory,
+		Path:       "/Shared/project",
+	},
+}
+
+func TestResourceDirectorySyncCreate(t *testing.T) {
+	dir := syncTestDir(t, map[string]string{
+		"a.py":      "print(1)",
+		"lib/b.sql": "SELECT 1",
+		"conf.json": "{}",
+		".git/HEAD": "ref: refs/heads/main",
+	})
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Shared/project",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "cHJpbnQoMSk=",
+					Path:      "/Shared/project/a",
+					Language:  "PYTHON",
+					Format:    "SOURCE",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "e30=",
+					Path:      "/Shared/project/conf.json",
+					Format:    "AUTO",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Shared/project/lib",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "U0VMRUNUIDE=",
+					Path:      "/Shared/project/lib/b",
+					Language:  "SQL",
+					Format:    "SOURCE",
+					Overwrite: true,
+				},
+			},
+			syncDirStatus,
+		},
+		Resource: ResourceDirectorySync(),
+		State: map[string]interface{}{
+			"source_dir": dir,
+			"path":       "/Shared/project",
+		},
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "/Shared/project", d.Id())
+	assert.Equal(t, 123, d.Get("object_id"))
+	files := d.Get("files").(map[string]interface{})
+	assert.Len(t, files, 3)
+	assert.Equal(t, "186bdbe41e79ea696410ba0a9e8d2762", files["a.py"])
+}
+
+func TestResourceDirectorySyncUpdate(t *testing.T) {
+	dir := syncTestDir(t, map[string]string{
+		"a.py":      "print(1)",
+		"lib/b.sql": "SELECT 2",
+	})
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Shared/project",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/Shared/project/lib",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "U0VMRUNUIDI=",
+					Path:      "/Shared/project/lib/b",
+					Language:  "SQL",
+					Format:    "SOURCE",
+					Overwrite: true,
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: NotebookDeleteRequest{
+					Path: "/Shared/project/gone",
+				},
+			},
+			syncDirStatus,
+		},
+		Resource: ResourceDirectorySync(),
+		InstanceState: map[string]string{
+			"source_dir":      dir,
+			"path":            "/Shared/project",
+			"files.%":         "3",
+			"files.a.py":      "186bdbe41e79ea696410ba0a9e8d2762",
+			"files.lib/b.sql": "b1698e52a0f16203489454196a0c6307",
+			"files.gone.py":   "abc",
+		},
+		State: map[string]interface{}{
+			"source_dir": dir,
+			"path":       "/Shared/project",
+		},
+		ID:     "/Shared/project",
+		Update: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Len(t, d.Get("files").(map[string]interface{}), 2)
+}
+
+func TestResourceDirectorySyncRead_NotDirectory(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fproject",
+				Response: ObjectStatus{
+					ObjectID:   123,
+					ObjectType: Notebook,
+					Path:       "/Shared/project",
+				},
+			},
+		},
+		Resource: ResourceDirectorySync(),
+		Read:     true,
+		ID:       "/Shared/project",
+	}.ExpectError(t, "different object type, NOTEBOOK, on this path other than a directory")
+}
+
+func TestResourceDirectorySyncDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/delete",
+				ExpectedRequest: NotebookDeleteRequest{
+					Path:      "/Shared/project",
+					Recursive: true,
+				},
+			},
+		},
+		Resource: ResourceDirectorySync(),
+		Delete:   true,
+		ID:       "/Shared/project",
+	}.ApplyNoError(t)
+}
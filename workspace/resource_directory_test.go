@@ -165,6 +165,31 @@ func TestResourceDirectoryCreate_Error(t *testing.T) {
 	assert.Equal(t, "", d.Id(), "Id should be empty for error creates")
 }
 
+func TestResourceDirectoryCreate_AlreadyExists(t *testing.T) {
+	path := "/test/path"
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": path,
+				},
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_ALREADY_EXISTS",
+					Message:   "Node named 'path' already exists",
+				},
+				Status: 400,
+			},
+		},
+		Resource: ResourceDirectory(),
+		State: map[string]interface{}{
+			"path": path,
+		},
+		Create: true,
+	}.ExpectError(t, "cannot create directory /test/path: Node named 'path' already exists")
+}
+
 func TestResourceDirectoryDelete_Error(t *testing.T) {
 	path := "/test/path"
 	d, err := qa.ResourceFixture{
@@ -0,0 +1,148 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NewGitCredentialsAPI creates GitCredentialsAPI instance from provider meta
+func NewGitCredentialsAPI(ctx context.Context, m interface{}) GitCredentialsAPI {
+	return GitCredentialsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// GitCredentialsAPI exposes the Git Credentials API
+type GitCredentialsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// GitCredentialRequest is used to create or update Git credential
+type GitCredentialRequest struct {
+	PersonalAccessToken string `json:"personal_access_token,omitempty"`
+	GitUsername         string `json:"git_username,omitempty"`
+	GitProvider         string `json:"git_provider"`
+}
+
+// GitCredentialResponse contains Git credential without the token
+type GitCredentialResponse struct {
+	CredentialID int64  `json:"credential_id"`
+	GitProvider  string `json:"git_provider"`
+	GitUsername  string `json:"git_username,omitempty"`
+}
+
+type gitCredentialList struct {
+	Credentials []GitCredentialResponse `json:"credentials,omitempty"`
+}
+
+// Create creates Git credential for the current user
+func (a GitCredentialsAPI) Create(req GitCredentialRequest) (resp GitCredentialResponse, err error) {
+	err = a.client.Post(a.context, "/git-credentials", req, &resp)
+	return
+}
+
+// Read returns Git credential by ID
+func (a GitCredentialsAPI) Read(id string) (resp GitCredentialResponse, err error) {
+	err = a.client.Get(a.context, "/git-credentials/"+id, nil, &resp)
+	return
+}
+
+// Update changes Git credential
+func (a GitCredentialsAPI) Update(id string, req GitCredentialRequest) error {
+	return a.client.Patch(a.context, "/git-credentials/"+id, req)
+}
+
+// Delete removes Git credential
+func (a GitCredentialsAPI) Delete(id string) error {
+	return a.client.Delete(a.context, "/git-credentials/"+id, nil)
+}
+
+// List returns Git credentials of the current user. There could be only one at a time
+func (a GitCredentialsAPI) List() ([]GitCredentialResponse, error) {
+	var resp gitCredentialList
+	err := a.client.Get(a.context, "/git-credentials", nil, &resp)
+	return resp.Credentials, err
+}
+
+// ResourceGitCredential manages Git credentials of the calling user or service principal
+func ResourceGitCredential() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"git_provider": {
+			Type:     schema.TypeString,
+			Required: true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.EqualFold(old, new)
+			},
+		},
+		"git_username": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"personal_access_token": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{"GITHUB_TOKEN", "GITLAB_TOKEN", "AZDO_PERSONAL_ACCESS_TOKEN"}, nil),
+		},
+		"force": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+	request := func(d *schema.ResourceData) GitCredentialRequest {
+		return GitCredentialRequest{
+			GitProvider:         d.Get("git_provider").(string),
+			GitUsername:         d.Get("git_username").(string),
+			PersonalAccessToken: d.Get("personal_access_token").(string),
+		}
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			gitCredentialsAPI := NewGitCredentialsAPI(ctx, c)
+			req := request(d)
+			resp, err := gitCredentialsAPI.Create(req)
+			if err == nil {
+				d.SetId(fmt.Sprintf("%d", resp.CredentialID))
+				return nil
+			}
+			apiErr, ok := err.(common.APIError)
+			if !ok || apiErr.ErrorCode != "RESOURCE_ALREADY_EXISTS" || !d.Get("force").(bool) {
+				return err
+			}
+			creds, err := gitCredentialsAPI.List()
+			if err != nil {
+				return err
+			}
+			if len(creds) != 1 {
+				return fmt.Errorf("list of credentials is either empty or have more than one entry (%d)", len(creds))
+			}
+			id := fmt.Sprintf("%d", creds[0].CredentialID)
+			if err = gitCredentialsAPI.Update(id, req); err != nil {
+				return err
+			}
+			d.SetId(id)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			resp, err := NewGitCredentialsAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			d.Set("git_provider", resp.GitProvider)
+			d.Set("git_username", resp.GitUsername)
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewGitCredentialsAPI(ctx, c).Update(d.Id(), request(d))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewGitCredentialsAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,254 @@
+package workspace
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceGitCredentialRead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/git-credentials/121232342",
+				Response: GitCredentialResponse{
+					CredentialID: 121232342,
+					GitProvider:  "gitHub",
+					GitUsername:  "test",
+				},
+			},
+		},
+		Resource: ResourceGitCredential(),
+		Read:     true,
+		New:      true,
+		ID:       "121232342",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "121232342", d.Id())
+	assert.Equal(t, "gitHub", d.Get("git_provider"))
+	assert.Equal(t, "test", d.Get("git_username"))
+}
+
+func TestResourceGitCredentialRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/git-credentials/121232342",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Git credential with the given ID could not be found.",
+				},
+				Status: 404,
+			},
+		},
+		Resource: ResourceGitCredential(),
+		Read:     true,
+		Removed:  true,
+		ID:       "121232342",
+	}.ApplyNoError(t)
+}
+
+func TestResourceGitCredentialCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/git-credentials",
+				ExpectedRequest: GitCredentialRequest{
+					GitProvider:         "gitHub",
+					GitUsername:         "test",
+					PersonalAccessToken: "token",
+				},
+				Response: GitCredentialResponse{
+					CredentialID: 121232342,
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/git-credentials/121232342",
+				Response: GitCredentialResponse{
+					CredentialID: 121232342,
+					GitProvider:  "gitHub",
+					GitUsername:  "test",
+				},
+			},
+		},
+		Resource: ResourceGitCredential(),
+		State: map[string]interface{}{
+			"git_provider":          "gitHub",
+			"git_username":          "test",
+			"personal_access_token": "token",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "121232342", d.Id())
+}
+
+func TestResourceGitCredentialCreate_AlreadyExists(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/git-credentials",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_ALREADY_EXISTS",
+					Message:   "Only one Git credential is supported at this time.",
+				},
+				Status: 400,
+			},
+		},
+		Resource: ResourceGitCredential(),
+		State: map[string]interface{}{
+			"git_provider":          "gitHub",
+			"git_username":          "test",
+			"personal_access_token": "token",
+		},
+		Create: true,
+	}.ExpectError(t, "Only one Git credential is supported at this time.")
+}
+
+func TestResourceGitCredentialCreate_Force(t *testing.T) {
+	req := GitCredentialRequest{
+		GitProvider:         "gitHub",
+		GitUsername:         "test",
+		PersonalAccessToken: "token",
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/git-credentials",
+				ExpectedRequest: req,
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_ALREADY_EXISTS",
+					Message:   "Only one Git credential is supported at this time.",
+				},
+				Status: 400,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/git-credentials",
+				Response: gitCredentialList{
+					Credentials: []GitCredentialResponse{
+						{
+							CredentialID: 121232342,
+							GitProvider:  "gitLab",
+							GitUsername:  "other",
+						},
+					},
+				},
+			},
+			{
+				Method:          http.MethodPatch,
+				Resource:        "/api/2.0/git-credentials/121232342",
+				ExpectedRequest: req,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/git-credentials/121232342",
+				Response: GitCredentialResponse{
+					CredentialID: 121232342,
+					GitProvider:  "gitHub",
+					GitUsername:  "test",
+				},
+			},
+		},
+		Resource: ResourceGitCredential(),
+		State: map[string]interface{}{
+			"git_provider":          "gitHub",
+			"git_username":          "test",
+			"personal_access_token": "token",
+			"force":                 true,
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "121232342", d.Id())
+}
+
+func TestResourceGitCredentialCreate_ForceEmptyList(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/git-credentials",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_ALREADY_EXISTS",
+					Message:   "Only one Git credential is supported at this time.",
+				},
+				Status: 400,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/git-credentials",
+				Response: gitCredentialList{},
+			},
+		},
+		Resource: ResourceGitCredential(),
+		State: map[string]interface{}{
+			"git_provider":          "gitHub",
+			"personal_access_token": "token",
+			"force":                 true,
+		},
+		Create: true,
+	}.ExpectError(t, "list of credentials is either empty or have more than one entry (0)")
+}
+
+func TestResourceGitCredentialUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/git-credentials/121232342",
+				ExpectedRequest: GitCredentialRequest{
+					GitProvider:         "gitHub",
+					GitUsername:         "test",
+					PersonalAccessToken: "new token",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/git-credentials/121232342",
+				Response: GitCredentialResponse{
+					CredentialID: 121232342,
+					GitProvider:  "gitHub",
+					GitUsername:  "test",
+				},
+			},
+		},
+		Resource: ResourceGitCredential(),
+		InstanceState: map[string]string{
+			"git_provider":          "gitHub",
+			"git_username":          "test",
+			"personal_access_token": "token",
+		},
+		State: map[string]interface{}{
+			"git_provider":          "gitHub",
+			"git_username":          "test",
+			"personal_access_token": "new token",
+		},
+		ID:     "121232342",
+		Update: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceGitCredentialDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.0/git-credentials/121232342",
+			},
+		},
+		Resource: ResourceGitCredential(),
+		Delete:   true,
+		ID:       "121232342",
+	}.ApplyNoError(t)
+}
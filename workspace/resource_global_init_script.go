@@ -12,10 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-const (
-	defaultPosition = 10000
-	maxScriptSize   = 64 * 1024
-)
+const maxScriptSize = 64 * 1024
 
 // ResourceGlobalInitScript manages notebooks
 func ResourceGlobalInitScript() *schema.Resource {
@@ -37,10 +34,6 @@ func ResourceGlobalInitScript() *schema.Resource {
 			Optional:         true,
 			Computed:         true,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				defaultPosString := fmt.Sprintf("%d", defaultPosition)
-				return (old == new) || (old != "" && new == defaultPosString && old != defaultPosString)
-			},
 		},
 	}
 	s := FileContentSchemaWithoutPath(extra)
@@ -54,13 +47,19 @@ func ResourceGlobalInitScript() *schema.Resource {
 				return fmt.Errorf("size of the global init script (%d bytes) exceeds maximal allowed (%d bytes)",
 					contentLen, maxScriptSize)
 			}
-			globalInitScriptsAPI := NewGlobalInitScriptsAPI(ctx, c)
-			scriptID, err := globalInitScriptsAPI.Create(GlobalInitScriptPayload{
+			payload := GlobalInitScriptPayload{
 				ContentBase64: base64.StdEncoding.EncodeToString(content),
 				Enabled:       d.Get("enabled").(bool),
-				Position:      int32(d.Get("position").(int)),
 				Name:          d.Get("name").(string),
-			})
+			}
+			// explicit zero position puts the script first, so it's different from
+			// the absent one, that appends the script to the end
+			if v, ok := d.GetOkExists("position"); ok {
+				position := int32(v.(int))
+				payload.Position = &position
+			}
+			globalInitScriptsAPI := NewGlobalInitScriptsAPI(ctx, c)
+			scriptID, err := globalInitScriptsAPI.Create(payload)
 			if err != nil {
 				return err
 			}
@@ -84,13 +83,19 @@ func ResourceGlobalInitScript() *schema.Resource {
 				return fmt.Errorf("size of the global init script (%d bytes) exceeds maximal allowed (%d bytes)",
 					contentLen, maxScriptSize)
 			}
-			globalInitScriptsAPI := NewGlobalInitScriptsAPI(ctx, c)
-			return globalInitScriptsAPI.Update(d.Id(), GlobalInitScriptPayload{
+			payload := GlobalInitScriptPayload{
 				ContentBase64: base64.StdEncoding.EncodeToString(content),
 				Enabled:       d.Get("enabled").(bool),
-				Position:      int32(d.Get("position").(int)),
 				Name:          d.Get("name").(string),
-			})
+			}
+			// position in the state may be outdated by reordering of other scripts,
+			// so it's sent only when changed in the configuration
+			if d.HasChange("position") {
+				position := int32(d.Get("position").(int))
+				payload.Position = &position
+			}
+			globalInitScriptsAPI := NewGlobalInitScriptsAPI(ctx, c)
+			return globalInitScriptsAPI.Update(d.Id(), payload)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewGlobalInitScriptsAPI(ctx, c).Delete(d.Id())
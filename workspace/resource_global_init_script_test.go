@@ -160,7 +160,6 @@ func TestResourceGlobalInitScriptUpdate(t *testing.T) {
 				Resource: "/api/2.0/global-init-scripts/1234",
 				ExpectedRequest: GlobalInitScriptPayload{
 					Name:          "test",
-					Position:      0,
 					ContentBase64: "ZWNobyBoZWxsbw==",
 				},
 				Response: globalInitScriptCreateResponse{
@@ -192,3 +191,83 @@ func TestResourceGlobalInitScriptUpdate(t *testing.T) {
 	assert.Equal(t, "1234", d.Id())
 	assert.Equal(t, 0, d.Get("position"))
 }
+
+func TestResourceGlobalInitScriptCreate_ExplicitZeroPosition(t *testing.T) {
+	position := int32(0)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/global-init-scripts",
+				ExpectedRequest: GlobalInitScriptPayload{
+					Name:          "test",
+					Position:      &position,
+					ContentBase64: "ZWNobyBoZWxsbw==",
+				},
+				Response: globalInitScriptCreateResponse{
+					ScriptID: "1234",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/global-init-scripts/1234",
+				ReuseRequest: true,
+				Response: GlobalInitScriptInfo{
+					ScriptID:      "1234",
+					ContentBase64: "ZWNobyBoZWxsbw==",
+					Position:      0,
+					Name:          "test",
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceGlobalInitScript(),
+		HCL: `name = "test"
+		content_base64 = "ZWNobyBoZWxsbw=="
+		position = 0`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 0, d.Get("position"))
+}
+
+func TestResourceGlobalInitScriptUpdate_PositionChanged(t *testing.T) {
+	position := int32(1)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/global-init-scripts/1234",
+				ExpectedRequest: GlobalInitScriptPayload{
+					Name:          "test",
+					Position:      &position,
+					ContentBase64: "ZWNobyBoZWxsbw==",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/global-init-scripts/1234",
+				Response: GlobalInitScriptInfo{
+					ScriptID:      "1234",
+					ContentBase64: "ZWNobyBoZWxsbw==",
+					Position:      1,
+					Name:          "test",
+				},
+			},
+		},
+		Update:   true,
+		ID:       "1234",
+		Resource: ResourceGlobalInitScript(),
+		InstanceState: map[string]string{
+			"name":           "test",
+			"content_base64": "ZWNobyBoZWxsbw==",
+			"position":       "3",
+		},
+		State: map[string]interface{}{
+			"name":           "test",
+			"content_base64": "ZWNobyBoZWxsbw==",
+			"position":       1,
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 1, d.Get("position"))
+}
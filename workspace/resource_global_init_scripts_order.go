@@ -0,0 +1,68 @@
+package workspace
+
+import (
+	"context"
+	"sort"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceGlobalInitScriptsOrder maintains execution order of global init scripts
+func ResourceGlobalInitScriptsOrder() *schema.Resource {
+	reorder := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var scriptIDs []string
+		for _, v := range d.Get("script_ids").([]interface{}) {
+			scriptIDs = append(scriptIDs, v.(string))
+		}
+		err := NewGlobalInitScriptsAPI(ctx, c).Reorder(scriptIDs)
+		if err != nil {
+			return err
+		}
+		d.SetId("_")
+		return nil
+	}
+	return common.Resource{
+		Create: reorder,
+		Update: reorder,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			scripts, err := NewGlobalInitScriptsAPI(ctx, c).List()
+			if err != nil {
+				return err
+			}
+			managed := map[string]bool{}
+			for _, v := range d.Get("script_ids").([]interface{}) {
+				managed[v.(string)] = true
+			}
+			sort.SliceStable(scripts, func(i, j int) bool {
+				return scripts[i].Position < scripts[j].Position
+			})
+			// managed scripts are reported in their actual execution order, so that
+			// scripts moved or removed outside of Terraform are shown as drift
+			scriptIDs := []string{}
+			for _, script := range scripts {
+				if managed[script.ScriptID] {
+					scriptIDs = append(scriptIDs, script.ScriptID)
+				}
+			}
+			return d.Set("script_ids", scriptIDs)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// scripts keep their positions, once the ordering is no longer managed
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"script_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}.ToResource()
+}
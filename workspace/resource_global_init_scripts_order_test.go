@@ -0,0 +1,165 @@
+package workspace
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceGlobalInitScriptsOrderCreate(t *testing.T) {
+	first, second := int32(0), int32(1)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/global-init-scripts/b",
+				Response: GlobalInitScriptInfo{
+					ScriptID:      "b",
+					Name:          "second",
+					Position:      2,
+					Enabled:       true,
+					ContentBase64: "ZWNobyBi",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/global-init-scripts/b",
+				ExpectedRequest: GlobalInitScriptPayload{
+					Name:          "second",
+					Position:      &first,
+					Enabled:       true,
+					ContentBase64: "ZWNobyBi",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/global-init-scripts/a",
+				Response: GlobalInitScriptInfo{
+					ScriptID:      "a",
+					Name:          "first",
+					Position:      0,
+					ContentBase64: "ZWNobyBh",
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/global-init-scripts/a",
+				ExpectedRequest: GlobalInitScriptPayload{
+					Name:          "first",
+					Position:      &second,
+					ContentBase64: "ZWNobyBh",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/global-init-scripts",
+				Response: globalInitScriptListResponse{
+					Scripts: []GlobalInitScriptInfo{
+						{ScriptID: "c", Position: 2},
+						{ScriptID: "a", Position: 1},
+						{ScriptID: "b", Position: 0},
+					},
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScriptsOrder(),
+		Create:   true,
+		HCL:      `script_ids = ["b", "a"]`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "_", d.Id())
+	assert.Equal(t, []interface{}{"b", "a"}, d.Get("script_ids"))
+}
+
+func TestResourceGlobalInitScriptsOrderCreate_AlreadyOrdered(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/global-init-scripts/a",
+				Response: GlobalInitScriptInfo{
+					ScriptID: "a",
+					Position: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/global-init-scripts",
+				Response: globalInitScriptListResponse{
+					Scripts: []GlobalInitScriptInfo{
+						{ScriptID: "a", Position: 0},
+					},
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScriptsOrder(),
+		Create:   true,
+		HCL:      `script_ids = ["a"]`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceGlobalInitScriptsOrderRead_Drift(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/global-init-scripts",
+				Response: globalInitScriptListResponse{
+					Scripts: []GlobalInitScriptInfo{
+						{ScriptID: "b", Position: 1},
+						{ScriptID: "x", Position: 0},
+						{ScriptID: "a", Position: 2},
+					},
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScriptsOrder(),
+		Read:     true,
+		New:      true,
+		ID:       "_",
+		HCL:      `script_ids = ["a", "b", "c"]`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, []interface{}{"b", "a"}, d.Get("script_ids"))
+}
+
+func TestResourceGlobalInitScriptsOrderUpdate_Error(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/global-init-scripts/a",
+				Response: GlobalInitScriptInfo{
+					ScriptID: "a",
+					Position: 1,
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/global-init-scripts/a",
+				Status:   400,
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message:   "Invalid position",
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScriptsOrder(),
+		Update:   true,
+		ID:       "_",
+		HCL:      `script_ids = ["a"]`,
+	}.Apply(t)
+	assert.EqualError(t, err, "cannot move global init script a to position 0: Invalid position")
+}
+
+func TestResourceGlobalInitScriptsOrderDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceGlobalInitScriptsOrder(),
+		Delete:   true,
+		ID:       "_",
+		HCL:      `script_ids = ["a"]`,
+	}.ApplyNoError(t)
+}
@@ -2,7 +2,10 @@ package workspace
 
 import (
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"fmt"
+	"log"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -28,6 +31,7 @@ const (
 	HTML    ExportFormat = "HTML"
 	Jupyter ExportFormat = "JUPYTER"
 	DBC     ExportFormat = "DBC"
+	Auto    ExportFormat = "AUTO"
 
 	Scala  Language = "SCALA"
 	Python Language = "PYTHON"
@@ -189,6 +193,36 @@ func (a NotebooksAPI) Delete(path string, recursive bool) error {
 	}, nil)
 }
 
+var formatExtMap = map[string]ExportFormat{
+	".ipynb": Jupyter,
+	".html":  HTML,
+}
+
+// notebookFormat returns explicitly configured format or guesses it from source file extension
+func notebookFormat(d interface{ Get(string) interface{} }) ExportFormat {
+	if format := d.Get("format").(string); format != "" {
+		return ExportFormat(format)
+	}
+	ext := strings.ToLower(filepath.Ext(d.Get("source").(string)))
+	if format, ok := formatExtMap[ext]; ok {
+		return format
+	}
+	return Source
+}
+
+// exportMD5 returns checksum of notebook source, as it's currently stored in the workspace
+func (a NotebooksAPI) exportMD5(path string) (string, error) {
+	content, err := a.Export(path, Source)
+	if err != nil {
+		return "", err
+	}
+	raw, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", md5.Sum(raw)), nil
+}
+
 // ResourceNotebook manages notebooks
 func ResourceNotebook() *schema.Resource {
 	s := FileContentSchema(map[string]*schema.Schema{
@@ -202,6 +236,10 @@ func ResourceNotebook() *schema.Resource {
 				string(SQL),
 			}, false),
 			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				if new == "" && notebookFormat(d) != Source {
+					// language is stored within Jupyter and HTML notebooks
+					return true
+				}
 				source := d.Get("source").(string)
 				if source == "" {
 					return false
@@ -209,6 +247,19 @@ func ResourceNotebook() *schema.Resource {
 				return old == extMap[strings.ToLower(filepath.Ext(source))]
 			},
 		},
+		"format": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(Source),
+				string(Jupyter),
+				string(HTML),
+			}, false),
+		},
+		"remote_md5": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 		"url": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -224,17 +275,52 @@ func ResourceNotebook() *schema.Resource {
 			Computed: true,
 		},
 	})
-	s["content_base64"].RequiredWith = []string{"language"}
+	importRequest := func(d *schema.ResourceData, path string) (ImportRequest, error) {
+		content, err := ReadContent(d)
+		if err != nil {
+			return ImportRequest{}, err
+		}
+		format := notebookFormat(d)
+		lang := d.Get("language").(string)
+		if lang == "" && format == Source {
+			lang = extMap[strings.ToLower(filepath.Ext(d.Get("source").(string)))]
+			if lang == "" {
+				return ImportRequest{}, fmt.Errorf("language is required for %s format", format)
+			}
+		}
+		// checksum of remote content is recorded on the next read
+		d.Set("remote_md5", "")
+		return ImportRequest{
+			Content:   base64.StdEncoding.EncodeToString(content),
+			Language:  lang,
+			Format:    string(format),
+			Overwrite: true,
+			Path:      path,
+		}, nil
+	}
 	return common.Resource{
 		Schema:        s,
 		SchemaVersion: 1,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			// only SOURCE format doesn't store language within the content
+			if !d.NewValueKnown("language") || d.Get("language").(string) != "" {
+				return nil
+			}
+			if d.NewValueKnown("content_base64") && d.Get("content_base64").(string) == "" {
+				return nil
+			}
+			if format := notebookFormat(d); format == Source {
+				return fmt.Errorf("language is required for %s format", format)
+			}
+			return nil
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			content, err := ReadContent(d)
+			path := d.Get("path").(string)
+			request, err := importRequest(d, path)
 			if err != nil {
 				return err
 			}
 			notebooksAPI := NewNotebooksAPI(ctx, c)
-			path := d.Get("path").(string)
 			parent := filepath.ToSlash(filepath.Dir(path))
 			if parent != "/" {
 				err = notebooksAPI.Mkdirs(parent)
@@ -243,18 +329,7 @@ func ResourceNotebook() *schema.Resource {
 					return err
 				}
 			}
-			lang := d.Get("language").(string)
-			if lang == "" {
-				// TODO: check what happens with empty source
-				lang = extMap[strings.ToLower(filepath.Ext(d.Get("source").(string)))]
-			}
-			if err = notebooksAPI.Create(ImportRequest{
-				Content:   base64.StdEncoding.EncodeToString(content),
-				Language:  lang,
-				Format:    "SOURCE",
-				Overwrite: true,
-				Path:      path,
-			}); err != nil {
+			if err = notebooksAPI.Create(request); err != nil {
 				return err
 			}
 			d.SetId(path)
@@ -266,22 +341,26 @@ func ResourceNotebook() *schema.Resource {
 			if err != nil {
 				return err
 			}
+			remoteMD5, err := notebooksAPI.exportMD5(d.Id())
+			if err != nil {
+				return err
+			}
+			knownMD5 := d.Get("remote_md5").(string)
+			if knownMD5 != "" && knownMD5 != remoteMD5 {
+				log.Printf("[INFO] Notebook %s was changed outside of Terraform", d.Id())
+				// forces the diff with local content checksum
+				d.Set("md5", "different")
+			}
+			d.Set("remote_md5", remoteMD5)
 			d.Set("url", c.FormatURL("#workspace", d.Id()))
 			return common.StructToData(objectStatus, s, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			notebooksAPI := NewNotebooksAPI(ctx, c)
-			content, err := ReadContent(d)
+			request, err := importRequest(d, d.Id())
 			if err != nil {
 				return err
 			}
-			return notebooksAPI.Create(ImportRequest{
-				Content:   base64.StdEncoding.EncodeToString(content),
-				Language:  d.Get("language").(string),
-				Format:    "SOURCE",
-				Overwrite: true,
-				Path:      d.Id(),
-			})
+			return NewNotebooksAPI(ctx, c).Create(request)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewNotebooksAPI(ctx, c).Delete(d.Id(), true)
@@ -1,6 +1,7 @@
 package workspace
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -27,6 +29,13 @@ func TestResourceNotebookRead(t *testing.T) {
 					Language:   "PYTHON",
 				},
 			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/export?format=SOURCE&path=%2Ftest%2Fpath.py",
+				Response: NotebookContent{
+					Content: "YWJjCg==",
+				},
+			},
 		},
 		Resource: ResourceNotebook(),
 		Read:     true,
@@ -38,6 +47,7 @@ func TestResourceNotebookRead(t *testing.T) {
 	assert.Equal(t, path, d.Get("path"))
 	assert.Equal(t, "PYTHON", d.Get("language"))
 	assert.Equal(t, objectID, d.Get("object_id"))
+	assert.Equal(t, "0bee89b07a248e27c83fc3d5951213c1", d.Get("remote_md5"))
 }
 
 func TestResourceNotebookDelete(t *testing.T) {
@@ -177,6 +187,13 @@ func TestResourceNotebookCreateSource(t *testing.T) {
 					Language:   "SQL",
 				},
 			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/export?format=SOURCE&path=%2FDashboard",
+				Response: NotebookContent{
+					Content: "YWJjCg==",
+				},
+			},
 		},
 		Resource: ResourceNotebook(),
 		State: map[string]interface{}{
@@ -259,6 +276,13 @@ func TestResourceNotebookUpdate(t *testing.T) {
 					Language:   "R",
 				},
 			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/export?format=SOURCE&path=abc",
+				Response: NotebookContent{
+					Content: "YWJjCg==",
+				},
+			},
 		},
 		Resource: ResourceNotebook(),
 		State: map[string]interface{}{
@@ -271,3 +295,206 @@ func TestResourceNotebookUpdate(t *testing.T) {
 	}.Apply(t)
 	require.NoError(t, err)
 }
+
+func TestResourceNotebookCreate_Jupyter(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content: "eyJjZWxscyI6W3siY2VsbF90eXBlIjoiY29kZSIsInNvdXJjZSI6WyJwcmlu" +
+						"dChcImhlbGxvIHdvcmxkXCIpIl0sIm1ldGFkYXRhIjp7fSwib3V0cHV0cyI6" +
+						"W10sImV4ZWN1dGlvbl9jb3VudCI6MX0seyJjZWxsX3R5cGUiOiJjb2RlIiwi" +
+						"c291cmNlIjpbInByaW50KFwiaG93IGFyZSB5b3VcIikiXSwibWV0YWRhdGEi" +
+						"Ont9LCJvdXRwdXRzIjpbeyJtZXRhZGF0YSI6e30sIm91dHB1dF90eXBlIjoi" +
+						"ZGlzcGxheV9kYXRhIiwiZGF0YSI6eyJ0ZXh0L2h0bWwiOlsiPHN0eWxlIHNj" +
+						"b3BlZD5cbiAgLmFuc2lvdXQge1xuICAgIGRpc3BsYXk6IGJsb2NrO1xuICAg" +
+						"IHVuaWNvZGUtYmlkaTogZW1iZWQ7XG4gICAgd2hpdGUtc3BhY2U6IHByZS13" +
+						"cmFwO1xuICAgIHdvcmQtd3JhcDogYnJlYWstd29yZDtcbiAgICB3b3JkLWJy" +
+						"ZWFrOiBicmVhay1hbGw7XG4gICAgZm9udC1mYW1pbHk6IFwiU291cmNlIENv" +
+						"ZGUgUHJvXCIsIFwiTWVubG9cIiwgbW9ub3NwYWNlOztcbiAgICBmb250LXNp" +
+						"emU6IDEzcHg7XG4gICAgY29sb3I6ICM1NTU7XG4gICAgbWFyZ2luLWxlZnQ6" +
+						"IDRweDtcbiAgICBsaW5lLWhlaWdodDogMTlweDtcbiAgfVxuPC9zdHlsZT5c" +
+						"bjxkaXYgY2xhc3M9XCJhbnNpb3V0XCI+aG93IGFyZSB5b3VcbjwvZGl2PiJd" +
+						"fX1dLCJleGVjdXRpb25fY291bnQiOjJ9LHsiY2VsbF90eXBlIjoiY29kZSIs" +
+						"InNvdXJjZSI6WyIiXSwibWV0YWRhdGEiOnt9LCJvdXRwdXRzIjpbXSwiZXhl" +
+						"Y3V0aW9uX2NvdW50IjozfV0sIm1ldGFkYXRhIjp7Im5hbWUiOiJ0ZXN0X2p1" +
+						"cHl0ZXIiLCJub3RlYm9va0lkIjoxMjc1OTg0MjQzMjkzMDI4fSwibmJmb3Jt" +
+						"YXQiOjQsIm5iZm9ybWF0X21pbm9yIjowfQo=",
+					Path:      "/Mars",
+					Overwrite: true,
+					Format:    "JUPYTER",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FMars",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: "NOTEBOOK",
+					Path:       "/Mars",
+					Language:   "PYTHON",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/export?format=SOURCE&path=%2FMars",
+				Response: NotebookContent{
+					Content: "YWJjCg==",
+				},
+			},
+		},
+		Resource: ResourceNotebook(),
+		State: map[string]interface{}{
+			"source": "acceptance/testdata/tf-test-jupyter.ipynb",
+			"path":   "/Mars",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/Mars", d.Id())
+	assert.Equal(t, "", d.Get("language"))
+}
+
+func TestResourceNotebookCreate_ExplicitFormat(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "YWJjCg==",
+					Path:      "/Page",
+					Overwrite: true,
+					Format:    "HTML",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FPage",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: "NOTEBOOK",
+					Path:       "/Page",
+					Language:   "SCALA",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/export?format=SOURCE&path=%2FPage",
+				Response: NotebookContent{
+					Content: "YWJjCg==",
+				},
+			},
+		},
+		Resource: ResourceNotebook(),
+		State: map[string]interface{}{
+			"content_base64": "YWJjCg==",
+			"format":         "HTML",
+			"path":           "/Page",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+}
+
+func TestResourceNotebookCreate_NoLanguage(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceNotebook(),
+		State: map[string]interface{}{
+			"content_base64": "YWJjCg==",
+			"path":           "/Page",
+		},
+		Create: true,
+	}.ExpectError(t, "language is required for SOURCE format")
+}
+
+func TestResourceNotebookDiff_NoLanguage(t *testing.T) {
+	_, err := ResourceNotebook().Diff(context.Background(), &terraform.InstanceState{},
+		terraform.NewResourceConfigRaw(map[string]interface{}{
+			"content_base64": "YWJjCg==",
+			"path":           "/Page",
+		}), nil)
+	qa.AssertErrorStartsWith(t, err, "language is required for SOURCE format")
+
+	diff, err := ResourceNotebook().Diff(context.Background(), &terraform.InstanceState{},
+		terraform.NewResourceConfigRaw(map[string]interface{}{
+			"content_base64": "YWJjCg==",
+			"format":         "JUPYTER",
+			"path":           "/Page",
+		}), nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, diff)
+}
+
+func TestResourceNotebookRead_RemoteDrift(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Ftest%2Fpath.py",
+				Response: ObjectStatus{
+					ObjectID:   12345,
+					ObjectType: Notebook,
+					Path:       "/test/path.py",
+					Language:   "PYTHON",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/export?format=SOURCE&path=%2Ftest%2Fpath.py",
+				Response: NotebookContent{
+					Content: "ZGVmCg==",
+				},
+			},
+		},
+		Resource: ResourceNotebook(),
+		Read:     true,
+		InstanceState: map[string]string{
+			"path":           "/test/path.py",
+			"language":       "PYTHON",
+			"content_base64": "YWJjCg==",
+			"md5":            "0bee89b07a248e27c83fc3d5951213c1",
+			"remote_md5":     "0bee89b07a248e27c83fc3d5951213c1",
+		},
+		State: map[string]interface{}{
+			"path":           "/test/path.py",
+			"language":       "PYTHON",
+			"content_base64": "YWJjCg==",
+			"md5":            "0bee89b07a248e27c83fc3d5951213c1",
+			"remote_md5":     "0bee89b07a248e27c83fc3d5951213c1",
+		},
+		ID: "/test/path.py",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "different", d.Get("md5"))
+	assert.Equal(t, "614dd0e977becb4c6f7fa99e64549b12", d.Get("remote_md5"))
+}
+
+func TestResourceNotebookRead_ExportError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Ftest%2Fpath.py",
+				Response: ObjectStatus{
+					ObjectID:   12345,
+					ObjectType: Notebook,
+					Path:       "/test/path.py",
+					Language:   "PYTHON",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/export?format=SOURCE&path=%2Ftest%2Fpath.py",
+				Response: NotebookContent{
+					Content: "@@@",
+				},
+			},
+		},
+		Resource: ResourceNotebook(),
+		Read:     true,
+		ID:       "/test/path.py",
+	}.ExpectError(t, "illegal base64 data at input byte 0")
+}
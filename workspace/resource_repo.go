@@ -0,0 +1,243 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// NewReposAPI creates ReposAPI instance from provider meta
+func NewReposAPI(ctx context.Context, m interface{}) ReposAPI {
+	return ReposAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// ReposAPI exposes the Repos API
+type ReposAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// SparseCheckout limits checked out files to directories matching patterns
+type SparseCheckout struct {
+	Patterns []string `json:"patterns"`
+}
+
+// ReposInformation contains information about Git repository, checked out into workspace
+type ReposInformation struct {
+	ID             int64           `json:"id"`
+	URL            string          `json:"url"`
+	Provider       string          `json:"provider"`
+	Path           string          `json:"path,omitempty"`
+	Branch         string          `json:"branch,omitempty"`
+	HeadCommitID   string          `json:"head_commit_id,omitempty"`
+	SparseCheckout *SparseCheckout `json:"sparse_checkout,omitempty"`
+}
+
+type createRequest struct {
+	URL            string          `json:"url"`
+	Provider       string          `json:"provider"`
+	Path           string          `json:"path,omitempty"`
+	SparseCheckout *SparseCheckout `json:"sparse_checkout,omitempty"`
+}
+
+// Create clones Git repository into workspace
+func (a ReposAPI) Create(r createRequest) (ReposInformation, error) {
+	var resp ReposInformation
+	if r.Provider == "" {
+		r.Provider = GetGitProviderFromUrl(r.URL)
+		if r.Provider == "" {
+			return resp, fmt.Errorf("git_provider isn't specified and we can't detect provider from URL")
+		}
+	}
+	err := a.client.Post(a.context, "/repos", r, &resp)
+	return resp, err
+}
+
+// Read returns information about repository
+func (a ReposAPI) Read(id string) (ReposInformation, error) {
+	var resp ReposInformation
+	err := a.client.Get(a.context, "/repos/"+id, nil, &resp)
+	return resp, err
+}
+
+// Update checks out given branch or tag. Only one of them could be specified
+func (a ReposAPI) Update(id string, branch, tag string) error {
+	req := map[string]string{}
+	if tag != "" {
+		req["tag"] = tag
+	} else if branch != "" {
+		req["branch"] = branch
+	}
+	return a.client.Patch(a.context, "/repos/"+id, req)
+}
+
+// Delete removes repository from workspace
+func (a ReposAPI) Delete(id string) error {
+	return a.client.Delete(a.context, "/repos/"+id, nil)
+}
+
+var validPathRegex = regexp.MustCompile(`^/Repos/[^/]+/[^/]+$`)
+
+var gitProvidersMap = map[string]string{
+	"github.com":    "gitHub",
+	"dev.azure.com": "azureDevOpsServices",
+	"gitlab.com":    "gitLab",
+	"bitbucket.org": "bitbucketCloud",
+}
+
+// GetGitProviderFromUrl guesses Git provider from repository URL
+func GetGitProviderFromUrl(uri string) string {
+	provider := ""
+	u, err := url.Parse(uri)
+	if err == nil {
+		lhost := strings.ToLower(u.Host)
+		provider = gitProvidersMap[lhost]
+		if provider == "" && strings.HasPrefix(lhost, "git-codecommit.") && strings.HasSuffix(lhost, ".amazonaws.com") {
+			provider = "awsCodeCommit"
+		}
+	}
+	return provider
+}
+
+// ResourceRepo manages Git repositories, checked out into /Repos
+func ResourceRepo() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"url": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"git_provider": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.EqualFold(old, new)
+			},
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(validPathRegex,
+				"should have 3 components (/Repos/<directory>/<repo>)"),
+		},
+		"branch": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"tag"},
+			ValidateFunc:  validation.StringIsNotWhiteSpace,
+		},
+		"tag": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"branch"},
+			ValidateFunc:  validation.StringIsNotWhiteSpace,
+		},
+		"commit_hash": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"track_branch_head": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			ConflictsWith: []string{"tag"},
+		},
+		"sparse_checkout": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			// switching between full and sparse checkout requires new clone
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"patterns": {
+						Type:     schema.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			if d.Id() == "" || !d.Get("track_branch_head").(bool) {
+				return nil
+			}
+			// remote branch head is only known to Databricks after a pull, which must
+			// not happen during plan, so update is planned and it pulls the branch
+			return d.SetNewComputed("commit_hash")
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			reposAPI := NewReposAPI(ctx, c)
+			req := createRequest{
+				URL:      d.Get("url").(string),
+				Provider: d.Get("git_provider").(string),
+				Path:     d.Get("path").(string),
+			}
+			if patterns, ok := d.GetOk("sparse_checkout.0.patterns"); ok {
+				req.SparseCheckout = &SparseCheckout{}
+				for _, v := range patterns.([]interface{}) {
+					req.SparseCheckout.Patterns = append(req.SparseCheckout.Patterns, v.(string))
+				}
+			}
+			resp, err := reposAPI.Create(req)
+			if err != nil {
+				return err
+			}
+			d.SetId(fmt.Sprintf("%d", resp.ID))
+			branch := d.Get("branch").(string)
+			tag := d.Get("tag").(string)
+			if tag != "" || (branch != "" && branch != resp.Branch) {
+				return reposAPI.Update(d.Id(), branch, tag)
+			}
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			resp, err := NewReposAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			d.Set("url", resp.URL)
+			d.Set("git_provider", resp.Provider)
+			d.Set("path", resp.Path)
+			d.Set("branch", resp.Branch)
+			d.Set("commit_hash", resp.HeadCommitID)
+			if resp.SparseCheckout != nil {
+				d.Set("sparse_checkout", []interface{}{
+					map[string]interface{}{
+						"patterns": resp.SparseCheckout.Patterns,
+					},
+				})
+			} else {
+				d.Set("sparse_checkout", nil)
+			}
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// checkout of the same branch pulls its latest commit
+			return NewReposAPI(ctx, c).Update(d.Id(),
+				d.Get("branch").(string), d.Get("tag").(string))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewReposAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
@@ -0,0 +1,411 @@
+package workspace
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGitProviderFromUrl(t *testing.T) {
+	assert.Equal(t, "bitbucketCloud", GetGitProviderFromUrl("https://user@bitbucket.org/user/repo.git"))
+	assert.Equal(t, "gitHub", GetGitProviderFromUrl("https://github.com/user/repo.git"))
+	assert.Equal(t, "azureDevOpsServices", GetGitProviderFromUrl("https://user@dev.azure.com/user/project/_git/repo"))
+	assert.Equal(t, "gitLab", GetGitProviderFromUrl("https://gitlab.com/user/repo.git"))
+	assert.Equal(t, "awsCodeCommit", GetGitProviderFromUrl("https://git-codecommit.us-east-2.amazonaws.com/v1/repos/MyDemoRepo"))
+	assert.Equal(t, "", GetGitProviderFromUrl("https://github.example.com/user/repo.git"))
+	assert.Equal(t, "", GetGitProviderFromUrl("://github.com/user/repo.git"))
+}
+
+func TestResourceRepoRead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/repos/121232342",
+				Response: ReposInformation{
+					ID:           121232342,
+					URL:          "https://github.com/user/test.git",
+					Provider:     "gitHub",
+					Path:         "/Repos/user@domain/test",
+					Branch:       "main",
+					HeadCommitID: "1124323423abc23424",
+				},
+			},
+		},
+		Resource: ResourceRepo(),
+		Read:     true,
+		New:      true,
+		ID:       "121232342",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "121232342", d.Id())
+	assert.Equal(t, "main", d.Get("branch"))
+	assert.Equal(t, "1124323423abc23424", d.Get("commit_hash"))
+	assert.Equal(t, "gitHub", d.Get("git_provider"))
+	assert.Equal(t, "/Repos/user@domain/test", d.Get("path"))
+}
+
+func TestResourceRepoRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/repos/121232342",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Repo could not be found",
+				},
+				Status: 404,
+			},
+		},
+		Resource: ResourceRepo(),
+		Read:     true,
+		Removed:  true,
+		ID:       "121232342",
+	}.ApplyNoError(t)
+}
+
+func TestResourceRepoCreate_DetectProvider(t *testing.T) {
+	resp := ReposInformation{
+		ID:           121232342,
+		URL:          "https://github.com/user/test.git",
+		Provider:     "gitHub",
+		Path:         "/Repos/user@domain/test",
+		Branch:       "main",
+		HeadCommitID: "1124323423abc23424",
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/repos",
+				ExpectedRequest: createRequest{
+					URL:      "https://github.com/user/test.git",
+					Provider: "gitHub",
+				},
+				Response: resp,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/repos/121232342",
+				Response: resp,
+			},
+		},
+		Resource: ResourceRepo(),
+		State: map[string]interface{}{
+			"url": "https://github.com/user/test.git",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "121232342", d.Id())
+	assert.Equal(t, "main", d.Get("branch"))
+}
+
+func TestResourceRepoCreate_UnknownProvider(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRepo(),
+		State: map[string]interface{}{
+			"url": "https://github.example.com/user/test.git",
+		},
+		Create: true,
+	}.ExpectError(t, "git_provider isn't specified and we can't detect provider from URL")
+}
+
+func TestResourceRepoCreate_WithBranch(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/repos",
+				ExpectedRequest: createRequest{
+					URL:      "https://ghe.example.com/user/test.git",
+					Provider: "gitHubEnterprise",
+					Path:     "/Repos/Production/test",
+				},
+				Response: ReposInformation{
+					ID:     121232342,
+					Branch: "main",
+				},
+			},
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/repos/121232342",
+				ExpectedRequest: map[string]string{
+					"branch": "releases",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/repos/121232342",
+				Response: ReposInformation{
+					ID:           121232342,
+					URL:          "https://ghe.example.com/user/test.git",
+					Provider:     "gitHubEnterprise",
+					Path:         "/Repos/Production/test",
+					Branch:       "releases",
+					HeadCommitID: "abc",
+				},
+			},
+		},
+		Resource: ResourceRepo(),
+		State: map[string]interface{}{
+			"url":          "https://ghe.example.com/user/test.git",
+			"git_provider": "gitHubEnterprise",
+			"path":         "/Repos/Production/test",
+			"branch":       "releases",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "releases", d.Get("branch"))
+}
+
+func TestResourceRepoCreate_InvalidPath(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRepo(),
+		State: map[string]interface{}{
+			"url":  "https://github.com/user/test.git",
+			"path": "/Users/test",
+		},
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [path] invalid value for path (should have 3 components (/Repos/<directory>/<repo>))")
+}
+
+func TestResourceRepoUpdate_SwitchToTag(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/repos/121232342",
+				ExpectedRequest: map[string]string{
+					"tag": "v0.1",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/repos/121232342",
+				Response: ReposInformation{
+					ID:           121232342,
+					URL:          "https://github.com/user/test.git",
+					Provider:     "gitHub",
+					Path:         "/Repos/user@domain/test",
+					HeadCommitID: "def",
+				},
+			},
+		},
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":          "https://github.com/user/test.git",
+			"git_provider": "gitHub",
+			"path":         "/Repos/user@domain/test",
+			"branch":       "main",
+			"commit_hash":  "abc",
+		},
+		State: map[string]interface{}{
+			"url": "https://github.com/user/test.git",
+			"tag": "v0.1",
+		},
+		ID:     "121232342",
+		Update: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "def", d.Get("commit_hash"))
+	assert.Equal(t, "v0.1", d.Get("tag"))
+}
+
+func TestResourceRepoDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.0/repos/121232342",
+			},
+		},
+		Resource: ResourceRepo(),
+		Delete:   true,
+		ID:       "121232342",
+	}.ApplyNoError(t)
+}
+
+func TestResourceRepoCreate_SparseCheckout(t *testing.T) {
+	resp := ReposInformation{
+		ID:           121232342,
+		URL:          "https://github.com/user/test.git",
+		Provider:     "gitHub",
+		Path:         "/Repos/user@domain/test",
+		Branch:       "main",
+		HeadCommitID: "1124323423abc23424",
+		SparseCheckout: &SparseCheckout{
+			Patterns: []string{"jobs", "libs/common"},
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/repos",
+				ExpectedRequest: createRequest{
+					URL:      "https://github.com/user/test.git",
+					Provider: "gitHub",
+					SparseCheckout: &SparseCheckout{
+						Patterns: []string{"jobs", "libs/common"},
+					},
+				},
+				Response: resp,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/repos/121232342",
+				Response: resp,
+			},
+		},
+		Resource: ResourceRepo(),
+		HCL: `
+		url = "https://github.com/user/test.git"
+		sparse_checkout {
+			patterns = ["jobs", "libs/common"]
+		}`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "121232342", d.Id())
+	assert.Equal(t, "libs/common", d.Get("sparse_checkout.0.patterns.1"))
+}
+
+func TestResourceRepoRead_SparseCheckout(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/repos/121232342",
+				Response: ReposInformation{
+					ID:       121232342,
+					URL:      "https://github.com/user/test.git",
+					Provider: "gitHub",
+					Path:     "/Repos/user@domain/test",
+					Branch:   "main",
+					SparseCheckout: &SparseCheckout{
+						Patterns: []string{"jobs"},
+					},
+				},
+			},
+		},
+		Resource: ResourceRepo(),
+		Read:     true,
+		New:      true,
+		ID:       "121232342",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 1, d.Get("sparse_checkout.#"))
+	assert.Equal(t, "jobs", d.Get("sparse_checkout.0.patterns.0"))
+}
+
+func TestResourceRepoUpdate_TrackBranchHead(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/repos/121232342",
+				ExpectedRequest: map[string]string{
+					"branch": "main",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/repos/121232342",
+				Response: ReposInformation{
+					ID:           121232342,
+					URL:          "https://github.com/user/test.git",
+					Provider:     "gitHub",
+					Path:         "/Repos/user@domain/test",
+					Branch:       "main",
+					HeadCommitID: "def",
+				},
+			},
+		},
+		Resource: ResourceRepo(),
+		InstanceState: map[string]string{
+			"url":               "https://github.com/user/test.git",
+			"git_provider":      "gitHub",
+			"path":              "/Repos/user@domain/test",
+			"branch":            "main",
+			"commit_hash":       "abc",
+			"track_branch_head": "true",
+		},
+		State: map[string]interface{}{
+			"url":               "https://github.com/user/test.git",
+			"branch":            "main",
+			"track_branch_head": true,
+		},
+		ID:     "121232342",
+		Update: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "def", d.Get("commit_hash"))
+}
+
+func repoTrackingBranchHead() *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: "121232342",
+		Attributes: map[string]string{
+			"id":                "121232342",
+			"url":               "https://github.com/user/test.git",
+			"git_provider":      "gitHub",
+			"path":              "/Repos/user@domain/test",
+			"branch":            "main",
+			"commit_hash":       "abc",
+			"track_branch_head": "true",
+		},
+	}
+}
+
+// repoDiff calculates diff with HTTP fixtures, that fail the test on any request, e.g. PATCH
+func repoDiff(t *testing.T, state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceDiff {
+	var diff *terraform.InstanceDiff
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{}, func(ctx context.Context, client *common.DatabricksClient) {
+		var err error
+		diff, err = ResourceRepo().Diff(ctx, state, terraform.NewResourceConfigRaw(config), client)
+		require.NoError(t, err)
+	})
+	return diff
+}
+
+func TestResourceRepoDiff_TrackBranchHead(t *testing.T) {
+	diff := repoDiff(t, repoTrackingBranchHead(), map[string]interface{}{
+		"url":               "https://github.com/user/test.git",
+		"branch":            "main",
+		"track_branch_head": true,
+	})
+	require.NotNil(t, diff)
+	assert.Equal(t, "abc", diff.Attributes["commit_hash"].Old)
+	assert.True(t, diff.Attributes["commit_hash"].NewComputed)
+}
+
+func TestResourceRepoDiff_TrackBranchHeadUpToDate(t *testing.T) {
+	// even if the branch has no new commits, plan can't know it without a pull
+	diff := repoDiff(t, repoTrackingBranchHead(), map[string]interface{}{
+		"url":               "https://github.com/user/test.git",
+		"branch":            "main",
+		"track_branch_head": true,
+	})
+	require.NotNil(t, diff)
+	assert.False(t, diff.RequiresNew())
+	assert.Len(t, diff.Attributes, 1)
+}
+
+func TestResourceRepoDiff_NotTrackingBranchHead(t *testing.T) {
+	state := repoTrackingBranchHead()
+	state.Attributes["track_branch_head"] = "false"
+	assert.Nil(t, repoDiff(t, state, map[string]interface{}{
+		"url":    "https://github.com/user/test.git",
+		"branch": "main",
+	}))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
@@ -37,11 +38,48 @@ func (a WorkspaceConfAPI) Read(conf *map[string]interface{}) error {
 	for k := range *conf {
 		keys = append(keys, k)
 	}
+	sort.Strings(keys)
 	return a.client.Get(a.context, "/workspace-conf", map[string]string{
 		"keys": strings.Join(keys, ","),
 	}, &conf)
 }
 
+// workspaceConfDefaults are documented values of configuration keys, that are
+// set on new workspaces and restored when keys are removed from configuration
+var workspaceConfDefaults = map[string]string{
+	"enableIpAccessLists":                              "false",
+	"maxTokenLifetimeDays":                             "",
+	"enableTokensConfig":                               "true",
+	"enableDeprecatedGlobalInitScripts":                "false",
+	"enableDeprecatedClusterNamedInitScripts":          "false",
+	"enableResultsDownloading":                         "true",
+	"enableNotebookTableClipboard":                     "true",
+	"enableExportNotebook":                             "true",
+	"enableUploadDataUis":                              "true",
+	"enableWorkspaceFilesystem":                        "true",
+	"enableProjectTypeInWorkspace":                     "true",
+	"enableVerboseAuditLogs":                           "false",
+	"enforceUserIsolation":                             "false",
+	"storeInteractiveNotebookResultsInCustomerAccount": "false",
+	"mlflowRunArtifactDownloadEnabled":                 "true",
+	"mlflowModelServingEndpointCreationEnabled":        "true",
+	"mlflowModelRegistryEmailNotificationsEnabled":     "true",
+}
+
+// defaultWorkspaceConfValue returns documented default of the key or guesses
+// disabled value from its name, e.g. `false` for `enableSomething`
+func defaultWorkspaceConfValue(k string) string {
+	if v, ok := workspaceConfDefaults[k]; ok {
+		return v
+	}
+	if strings.HasPrefix(k, "enable") ||
+		strings.HasPrefix(k, "enforce") ||
+		strings.HasSuffix(k, "Enabled") {
+		return "false"
+	}
+	return ""
+}
+
 // ResourceWorkspaceConf maintains workspace configuration for specified keys
 func ResourceWorkspaceConf() *schema.Resource {
 	create := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -63,13 +101,7 @@ func ResourceWorkspaceConf() *schema.Resource {
 				continue
 			}
 			log.Printf("[DEBUG] Erasing configuration of %s", k)
-			if strings.HasPrefix(k, "enable") ||
-				strings.HasPrefix(k, "enforce") ||
-				strings.HasSuffix(k, "Enabled") {
-				patch[k] = "false"
-			} else {
-				patch[k] = ""
-			}
+			patch[k] = defaultWorkspaceConfValue(k)
 		}
 		err := wsConfAPI.Update(patch)
 		if err != nil {
@@ -84,24 +116,31 @@ func ResourceWorkspaceConf() *schema.Resource {
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			wsConfAPI := NewWorkspaceConfAPI(ctx, c)
 			config := d.Get("custom_config").(map[string]interface{})
+			if d.Id() != "_" {
+				// resource is imported with comma-separated keys as ID
+				for _, k := range strings.Split(d.Id(), ",") {
+					config[strings.TrimSpace(k)] = ""
+				}
+				d.SetId("_")
+			}
 			log.Printf("[DEBUG] Config available in state: %v", config)
 			err := wsConfAPI.Read(&config)
 			if err != nil {
 				return err
 			}
+			for k, v := range config {
+				if v == nil {
+					// keys, that were never set, have their default values
+					config[k] = defaultWorkspaceConfValue(k)
+				}
+			}
 			log.Printf("[DEBUG] Setting new config to state: %v", config)
 			return d.Set("custom_config", config)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			config := d.Get("custom_config").(map[string]interface{})
 			for k := range config {
-				if strings.HasPrefix(k, "enable") ||
-					strings.HasPrefix(k, "enforce") ||
-					strings.HasSuffix(k, "Enabled") {
-					config[k] = "false"
-				} else {
-					config[k] = ""
-				}
+				config[k] = defaultWorkspaceConfValue(k)
 			}
 			wsConfAPI := NewWorkspaceConfAPI(ctx, c)
 			return wsConfAPI.Update(config)
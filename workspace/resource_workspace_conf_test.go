@@ -212,3 +212,79 @@ func TestWorkspaceConfDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "_", d.Id())
 }
+
+func TestWorkspaceConfImport(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists%2CmaxTokenLifetimeDays",
+				Response: map[string]interface{}{
+					"enableIpAccessLists":  "true",
+					"maxTokenLifetimeDays": nil,
+				},
+			},
+		},
+		Resource: ResourceWorkspaceConf(),
+		Read:     true,
+		New:      true,
+		ID:       "enableIpAccessLists, maxTokenLifetimeDays",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "_", d.Id())
+	assert.Equal(t, map[string]interface{}{
+		"enableIpAccessLists":  "true",
+		"maxTokenLifetimeDays": "",
+	}, d.Get("custom_config"))
+}
+
+func TestWorkspaceConfRead_ChangedOutside(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists%2CenableTokensConfig",
+				Response: map[string]interface{}{
+					"enableIpAccessLists": "false",
+					"enableTokensConfig":  nil,
+				},
+			},
+		},
+		Resource: ResourceWorkspaceConf(),
+		Read:     true,
+		ID:       "_",
+		State: map[string]interface{}{
+			"custom_config": map[string]interface{}{
+				"enableIpAccessLists": "true",
+				"enableTokensConfig":  "false",
+			},
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "false", d.Get("custom_config.enableIpAccessLists"))
+	assert.Equal(t, "true", d.Get("custom_config.enableTokensConfig"))
+}
+
+func TestWorkspaceConfDelete_RestoresDefaults(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableTokensConfig":       "true",
+					"enableResultsDownloading": "true",
+					"maxTokenLifetimeDays":     "",
+				},
+			},
+		},
+		HCL: `custom_config {
+			enableTokensConfig = "false"
+			enableResultsDownloading = "false"
+			maxTokenLifetimeDays = "90"
+		}`,
+		Resource: ResourceWorkspaceConf(),
+		Delete:   true,
+		ID:       "_",
+	}.ApplyNoError(t)
+}